@@ -27,6 +27,8 @@ type PackagesOptions struct {
 	ImportTimeout          uint
 	Catalogers             []string
 	ExternalSourcesEnabled bool
+	CPECandidates          string
+	CPEFilters             string
 }
 
 var _ Interface = (*PackagesOptions)(nil)
@@ -74,6 +76,12 @@ func (o *PackagesOptions) AddFlags(cmd *cobra.Command, v *viper.Viper) error {
 	cmd.Flags().BoolVarP(&o.ExternalSourcesEnabled, "external-sources-enabled", "", false,
 		"shut off any use of external sources during sbom generation (default false")
 
+	cmd.Flags().StringVarP(&o.CPECandidates, "cpe-candidates", "", "",
+		"a YAML or JSON file of additional CPE product candidates by package type and name")
+
+	cmd.Flags().StringVarP(&o.CPEFilters, "cpe-filters", "", "",
+		"a YAML or JSON file of rules for additional CPE false positives to remove")
+
 	return bindPackageConfigOptions(cmd.Flags(), v)
 }
 
@@ -113,6 +121,14 @@ func bindPackageConfigOptions(flags *pflag.FlagSet, v *viper.Viper) error {
 		return err
 	}
 
+	if err := v.BindPFlag("package.cpe-candidates", flags.Lookup("cpe-candidates")); err != nil {
+		return err
+	}
+
+	if err := v.BindPFlag("package.cpe-filters", flags.Lookup("cpe-filters")); err != nil {
+		return err
+	}
+
 	// Upload options //////////////////////////////////////////////////////////
 
 	if err := v.BindPFlag("anchore.host", flags.Lookup("host")); err != nil {
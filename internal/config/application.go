@@ -69,6 +69,8 @@ func (cfg Application) ToCatalogerConfig() cataloger.Config {
 		},
 		Catalogers:             cfg.Catalogers,
 		ExternalSourcesEnabled: cfg.ExternalSources.ExternalSourcesEnabled,
+		DisableCPEs:            !cfg.Package.GenerateCPEs,
+		CPE:                    cfg.Package.CPE,
 	}
 }
 
@@ -1,7 +1,11 @@
 package config
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/anchore/syft/syft/pkg/cataloger"
+	"github.com/anchore/syft/syft/pkg/cataloger/common/cpe"
 	"github.com/spf13/viper"
 )
 
@@ -9,6 +13,11 @@ type pkg struct {
 	Cataloger               catalogerOptions `yaml:"cataloger" json:"cataloger" mapstructure:"cataloger"`
 	SearchUnindexedArchives bool             `yaml:"search-unindexed-archives" json:"search-unindexed-archives" mapstructure:"search-unindexed-archives"`
 	SearchIndexedArchives   bool             `yaml:"search-indexed-archives" json:"search-indexed-archives" mapstructure:"search-indexed-archives"`
+	CPECandidates           string           `yaml:"cpe-candidates" json:"cpe-candidates" mapstructure:"cpe-candidates"` // path to a file of additional CPE product candidates by package type and name
+	CPEFilters              string           `yaml:"cpe-filters" json:"cpe-filters" mapstructure:"cpe-filters"`          // path to a file of rules for additional CPE false positives to remove
+	CPETargetSoftware       bool             `yaml:"cpe-target-software" json:"cpe-target-software" mapstructure:"cpe-target-software"`
+	GenerateCPEs            bool             `yaml:"generate-cpes" json:"generate-cpes" mapstructure:"generate-cpes"`
+	CPE                     cpe.Config       `yaml:"-" json:"-" mapstructure:"-"`
 }
 
 func (cfg pkg) loadDefaultValues(v *viper.Viper) {
@@ -16,8 +25,40 @@ func (cfg pkg) loadDefaultValues(v *viper.Viper) {
 	c := cataloger.DefaultSearchConfig()
 	v.SetDefault("package.search-unindexed-archives", c.IncludeUnindexedArchives)
 	v.SetDefault("package.search-indexed-archives", c.IncludeIndexedArchives)
+	v.SetDefault("package.generate-cpes", !cataloger.DefaultConfig().DisableCPEs)
+	v.SetDefault("package.cpe-target-software", cpe.DefaultConfig().IncludeTargetSoftware)
 }
 
 func (cfg *pkg) parseConfigValues() error {
+	if cfg.CPECandidates != "" {
+		f, err := os.Open(cfg.CPECandidates)
+		if err != nil {
+			return fmt.Errorf("unable to open CPE candidates file: %w", err)
+		}
+		defer f.Close()
+
+		candidates, err := cpe.ReadProductCandidates(f)
+		if err != nil {
+			return fmt.Errorf("unable to read CPE candidates file %q: %w", cfg.CPECandidates, err)
+		}
+		cfg.CPE.ProductCandidates = candidates
+	}
+
+	if cfg.CPEFilters != "" {
+		f, err := os.Open(cfg.CPEFilters)
+		if err != nil {
+			return fmt.Errorf("unable to open CPE filters file: %w", err)
+		}
+		defer f.Close()
+
+		filters, err := cpe.ReadFilters(f)
+		if err != nil {
+			return fmt.Errorf("unable to read CPE filters file %q: %w", cfg.CPEFilters, err)
+		}
+		cfg.CPE.Filters = filters
+	}
+
+	cfg.CPE.IncludeTargetSoftware = cfg.CPETargetSoftware
+
 	return cfg.Cataloger.parseConfigValues()
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/linux"
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common/cpe"
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
 )
@@ -364,14 +365,19 @@ func findPURLValue(p *spdx.Package2_2) string {
 func extractCPEs(p *spdx.Package2_2) (cpes []pkg.CPE) {
 	for _, r := range p.PackageExternalReferences {
 		if r.RefType == string(Cpe23ExternalRefType) {
-			cpe, err := pkg.NewCPE(r.Locator)
+			c, err := pkg.NewCPE(r.Locator)
 			if err != nil {
 				log.Warnf("unable to extract SPDX CPE=%q: %+v", r.Locator, err)
 				continue
 			}
-			cpes = append(cpes, cpe)
+			cpes = append(cpes, c)
 		}
 	}
+
+	if len(cpes) == 0 {
+		// the document has no CPEs for this package, so fallback to the project identity given by the other references
+		cpes = cpe.FromPackageIdentity(findPURLValue(p), p.PackageHomePage, p.PackageVersion)
+	}
 	return cpes
 }
 
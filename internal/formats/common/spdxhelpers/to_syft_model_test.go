@@ -116,6 +116,73 @@ func TestToSyftModel(t *testing.T) {
 	assert.Len(t, p2.CPEs, 3)
 }
 
+func Test_extractCPEs(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        *spdx.Package2_2
+		expected []string
+	}{
+		{
+			name: "cpe references",
+			p: &spdx.Package2_2{
+				PackageVersion: "2.28.1",
+				PackageExternalReferences: []*spdx.PackageExternalReference2_2{
+					{
+						Category: "SECURITY",
+						Locator:  "cpe:2.3:a:python:requests:2.28.1:*:*:*:*:*:*:*",
+						RefType:  "cpe23Type",
+					},
+					{
+						Category: "PACKAGE_MANAGER",
+						Locator:  "pkg:github/psf/requests@2.28.1",
+						RefType:  "purl",
+					},
+				},
+			},
+			expected: []string{"cpe:2.3:a:python:requests:2.28.1:*:*:*:*:*:*:*"},
+		},
+		{
+			name: "purl reference",
+			p: &spdx.Package2_2{
+				PackageVersion: "2.28.1",
+				PackageExternalReferences: []*spdx.PackageExternalReference2_2{
+					{
+						Category: "PACKAGE_MANAGER",
+						Locator:  "pkg:github/psf/requests@2.28.1",
+						RefType:  "purl",
+					},
+				},
+			},
+			expected: []string{"cpe:2.3:a:psf:requests:2.28.1:*:*:*:*:*:*:*"},
+		},
+		{
+			name: "website",
+			p: &spdx.Package2_2{
+				PackageVersion:  "7.74.0",
+				PackageHomePage: "https://github.com/curl/curl",
+			},
+			expected: []string{"cpe:2.3:a:curl:curl:7.74.0:*:*:*:*:*:*:*"},
+		},
+		{
+			name: "no identity",
+			p: &spdx.Package2_2{
+				PackageVersion:  "7.74.0",
+				PackageHomePage: NOASSERTION,
+			},
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var actual []string
+			for _, c := range extractCPEs(test.p) {
+				actual = append(actual, pkg.CPEString(c))
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
 func Test_extractMetadata(t *testing.T) {
 	oneTwoThreeFour := 1234
 	tests := []struct {
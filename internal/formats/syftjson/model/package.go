@@ -30,6 +30,7 @@ type PackageBasicData struct {
 	Licenses  []string             `json:"licenses"`
 	Language  pkg.Language         `json:"language"`
 	CPEs      []string             `json:"cpes"`
+	CPE22s    []string             `json:"cpe22s,omitempty"` // the CPE 2.2 URI binding of each CPE, for tooling that cannot parse CPE 2.3
 	PURL      string               `json:"purl"`
 }
 
@@ -170,8 +170,10 @@ func toPackageModels(catalog *pkg.Catalog) []model.Package {
 // toPackageModel crates a new Package from the given pkg.Package.
 func toPackageModel(p pkg.Package) model.Package {
 	var cpes = make([]string, len(p.CPEs))
+	var cpe22s []string
 	for i, c := range p.CPEs {
 		cpes[i] = pkg.CPEString(c)
+		cpe22s = append(cpe22s, pkg.CPEURIString(c))
 	}
 
 	var licenses = make([]string, 0)
@@ -196,6 +198,7 @@ func toPackageModel(p pkg.Package) model.Package {
 			Licenses:  licenses,
 			Language:  p.Language,
 			CPEs:      cpes,
+			CPE22s:    cpe22s,
 			PURL:      p.PURL,
 		},
 		PackageCustomData: model.PackageCustomData{
@@ -8,6 +8,7 @@ import (
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
+	"github.com/anchore/syft/syft/pkg"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -45,5 +46,13 @@ var funcMap = func() template.FuncMap {
 
 		return 0
 	}
+	// cpe22 converts a CPE 2.3 formatted string into the CPE 2.2 URI binding (or an empty string if it is not a valid CPE)
+	f["cpe22"] = func(cpe string) string {
+		c, err := pkg.NewCPE(cpe)
+		if err != nil {
+			return ""
+		}
+		return pkg.CPEURIString(c)
+	}
 	return f
 }()
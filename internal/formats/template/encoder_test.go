@@ -1,11 +1,14 @@
 package template
 
 import (
+	"bytes"
 	"flag"
 	"testing"
+	"text/template"
 
 	"github.com/anchore/syft/internal/formats/common/testutils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var updateTmpl = flag.Bool("update-tmpl", false, "update the *.golden files for json encoders")
@@ -27,3 +30,16 @@ func TestFormatWithoutOptions(t *testing.T) {
 	err := f.Encode(nil, testutils.DirectoryInput(t))
 	assert.ErrorContains(t, err, "no template file: please provide a template path")
 }
+
+func TestFuncMap_cpe22(t *testing.T) {
+	tmpl, err := template.New("test").Funcs(funcMap).Parse(`{{ range . }}{{ . }} {{ cpe22 . }};{{ end }}`)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, []string{
+		"cpe:2.3:a:some-vendor:name:3.2:*:*:*:*:*:*:*",
+		"not-a-cpe",
+	}))
+
+	assert.Equal(t, "cpe:2.3:a:some-vendor:name:3.2:*:*:*:*:*:*:* cpe:/a:some-vendor:name:3.2;not-a-cpe ;", buf.String())
+}
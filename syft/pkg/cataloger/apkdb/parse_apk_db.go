@@ -154,6 +154,11 @@ func parseApkDBEntry(reader io.Reader) (*pkg.ApkMetadata, error) {
 	if err := mapstructure.Decode(pkgFields, &entry); err != nil {
 		return nil, fmt.Errorf("unable to parse APK metadata: %w", err)
 	}
+	if _, ok := pkgFields["p"]; !ok {
+		// mapstructure falls back to a case-insensitive match when a key is missing, which would otherwise assign the
+		// package name ("P") as the provides ("p") value
+		entry.Provides = ""
+	}
 	if entry.Package == "" {
 		return nil, nil
 	}
@@ -42,6 +42,12 @@ func newMonitor() (*progress.Manual, *progress.Manual) {
 // done in bulk. Specifically, all files of interest are collected from each catalogers and accumulated into a single
 // request.
 func Catalog(resolver source.FileResolver, release *linux.Release, catalogers ...Cataloger) (*pkg.Catalog, []artifact.Relationship, error) {
+	return CatalogWithConfig(resolver, release, DefaultConfig(), catalogers...)
+}
+
+// CatalogWithConfig is the same as Catalog, however, CPEs are generated for all discovered packages with the CPE config,
+// unless CPE generation is disabled.
+func CatalogWithConfig(resolver source.FileResolver, release *linux.Release, cfg Config, catalogers ...Cataloger) (*pkg.Catalog, []artifact.Relationship, error) {
 	catalog := pkg.NewCatalog()
 	var allRelationships []artifact.Relationship
 
@@ -64,12 +70,17 @@ func Catalog(resolver source.FileResolver, release *linux.Release, catalogers ..
 		packagesDiscovered.N += int64(catalogedPackages)
 
 		for _, p := range packages {
-			// generate CPEs (note: this is excluded from package ID, so is safe to mutate)
-			p.CPEs = cpe.Generate(p)
-
 			// generate PURL (note: this is excluded from package ID, so is safe to mutate)
 			p.PURL = pkg.URL(p, release)
 
+			// generate CPEs, which may use the PURL as a source of candidates (note: this is excluded from package ID,
+			// so is safe to mutate)
+			if cfg.DisableCPEs {
+				p.CPEs = []pkg.CPE{}
+			} else {
+				p.CPEs = cpe.GenerateWithRelease(p, release, cfg.CPE)
+			}
+
 			// if we were not able to identify the language we have an opportunity
 			// to try and get this value from the PURL. Worst case we assert that
 			// we could not identify the language at either stage and set UnknownLanguage
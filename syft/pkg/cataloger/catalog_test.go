@@ -0,0 +1,96 @@
+package cataloger
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ Cataloger = (*staticCataloger)(nil)
+
+// staticCataloger always discovers the same packages
+type staticCataloger struct {
+	packages []pkg.Package
+}
+
+func (c staticCataloger) Name() string {
+	return "static-cataloger"
+}
+
+func (c staticCataloger) Catalog(_ source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	return c.packages, nil, nil
+}
+
+func (c staticCataloger) UsesExternalSources() bool {
+	return false
+}
+
+func TestCatalog_DisableCPEs(t *testing.T) {
+	p := pkg.Package{
+		Name:    "bash",
+		Version: "5.1",
+		Type:    pkg.DebPkg,
+	}
+	p.SetID()
+	c := staticCataloger{packages: []pkg.Package{p}}
+
+	tests := []struct {
+		name       string
+		cfg        Config
+		assertCPEs func(t *testing.T, cpes []pkg.CPE)
+	}{
+		{
+			name: "default config",
+			cfg:  DefaultConfig(),
+			assertCPEs: func(t *testing.T, cpes []pkg.CPE) {
+				assert.NotEmpty(t, cpes)
+			},
+		},
+		{
+			// library callers that build the config themselves must still get CPEs
+			name: "zero value config",
+			cfg:  Config{},
+			assertCPEs: func(t *testing.T, cpes []pkg.CPE) {
+				assert.NotEmpty(t, cpes)
+			},
+		},
+		{
+			name: "disabled",
+			cfg:  Config{DisableCPEs: true},
+			assertCPEs: func(t *testing.T, cpes []pkg.CPE) {
+				assert.NotNil(t, cpes)
+				assert.Empty(t, cpes)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			catalog, _, err := CatalogWithConfig(source.NewMockResolverForPaths(), nil, test.cfg, c)
+			require.NoError(t, err)
+
+			packages := catalog.Sorted()
+			require.Len(t, packages, 1)
+			test.assertCPEs(t, packages[0].CPEs)
+		})
+	}
+}
+
+func TestCatalog_DefaultConfig(t *testing.T) {
+	p := pkg.Package{
+		Name:    "bash",
+		Version: "5.1",
+		Type:    pkg.DebPkg,
+	}
+	p.SetID()
+
+	catalog, _, err := Catalog(source.NewMockResolverForPaths(), nil, staticCataloger{packages: []pkg.Package{p}})
+	require.NoError(t, err)
+
+	packages := catalog.Sorted()
+	require.Len(t, packages, 1)
+	assert.NotEmpty(t, packages[0].CPEs)
+}
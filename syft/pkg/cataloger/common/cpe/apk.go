@@ -0,0 +1,35 @@
+package cpe
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// candidateProductsForAPK returns product candidates from the names an apk package provides. Only named capabilities
+// are considered (virtual package names and pkg-config names), since shared library (so:) and command (cmd:) entries
+// describe files within the package and not the software itself.
+func candidateProductsForAPK(p pkg.Package) (products []string) {
+	metadata, ok := p.Metadata.(pkg.ApkMetadata)
+	if !ok {
+		return nil
+	}
+
+	for _, provides := range strings.Fields(metadata.Provides) {
+		// drop any version constraint (e.g. pc:libcurl=7.83.1 -> pc:libcurl)
+		name := strings.SplitN(provides, "=", 2)[0]
+
+		switch {
+		case strings.HasPrefix(name, "pc:"):
+			name = strings.TrimPrefix(name, "pc:")
+		case strings.Contains(name, ":"):
+			// so:, cmd:, and any other capability types
+			continue
+		}
+
+		if name != "" && name != p.Name {
+			products = append(products, name)
+		}
+	}
+	return products
+}
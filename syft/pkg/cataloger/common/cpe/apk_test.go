@@ -0,0 +1,60 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_candidateProductsForAPK(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkg      pkg.Package
+		expected []string
+	}{
+		{
+			name: "named capabilities",
+			pkg: pkg.Package{
+				Name:         "libssl1.1",
+				Type:         pkg.ApkPkg,
+				MetadataType: pkg.ApkMetadataType,
+				Metadata: pkg.ApkMetadata{
+					Package:  "libssl1.1",
+					Provides: "so:libssl.so.1.1=1.1 pc:libssl=1.1.1q openssl-libs",
+				},
+			},
+			expected: []string{"libssl", "openssl-libs"},
+		},
+		{
+			name: "only files and commands",
+			pkg: pkg.Package{
+				Name:         "musl-utils",
+				Type:         pkg.ApkPkg,
+				MetadataType: pkg.ApkMetadataType,
+				Metadata: pkg.ApkMetadata{
+					Package:  "musl-utils",
+					Provides: "cmd:getconf cmd:getent cmd:iconv cmd:ldconfig cmd:ldd",
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "no provides",
+			pkg: pkg.Package{
+				Name:         "busybox",
+				Type:         pkg.ApkPkg,
+				MetadataType: pkg.ApkMetadataType,
+				Metadata: pkg.ApkMetadata{
+					Package: "busybox",
+				},
+			},
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, candidateProductsForAPK(test.pkg))
+		})
+	}
+}
@@ -45,6 +45,29 @@ var defaultCandidateAdditions = buildCandidateLookup(
 			candidateKey{PkgName: "apache-cassandra"}, // , Vendor: "apache"},
 			candidateAddition{AdditionalProducts: []string{"cassandra"}},
 		},
+		{
+			// example image: tomcat:latest
+			pkg.JavaPkg,
+			candidateKey{PkgName: "catalina"},
+			candidateAddition{AdditionalProducts: []string{"tomcat"}, AdditionalVendors: []string{"apache"}},
+		},
+		{
+			pkg.JavaPkg,
+			candidateKey{PkgName: "tomcat-catalina"},
+			candidateAddition{AdditionalProducts: []string{"tomcat"}, AdditionalVendors: []string{"apache"}},
+		},
+		{
+			// example: spring boot applications with an embedded servlet container
+			pkg.JavaPkg,
+			candidateKey{PkgName: "tomcat-embed-core"},
+			candidateAddition{AdditionalProducts: []string{"tomcat"}, AdditionalVendors: []string{"apache"}},
+		},
+		{
+			// example image: jetty:latest
+			pkg.JavaPkg,
+			candidateKey{PkgName: "jetty-server"},
+			candidateAddition{AdditionalProducts: []string{"jetty"}, AdditionalVendors: []string{"eclipse"}},
+		},
 		{
 			// example image: cloudbees/cloudbees-core-mm:2.319.3.4
 			// this is a wrapped packaging of the handlebars.js node module
@@ -123,9 +146,12 @@ var defaultCandidateAdditions = buildCandidateLookup(
 		},
 	})
 
+// candidateStore holds all candidate additions by package type, then by the package info to match on.
+type candidateStore map[pkg.Type]map[candidateKey]candidateAddition
+
 // buildCandidateLookup is a convenience function for creating the defaultCandidateAdditions set
-func buildCandidateLookup(cc []candidateComposite) (ca map[pkg.Type]map[candidateKey]candidateAddition) {
-	ca = make(map[pkg.Type]map[candidateKey]candidateAddition)
+func buildCandidateLookup(cc []candidateComposite) (ca candidateStore) {
+	ca = make(candidateStore)
 	for _, c := range cc {
 		if _, ok := ca[c.Type]; !ok {
 			ca[c.Type] = make(map[candidateKey]candidateAddition)
@@ -148,29 +174,51 @@ type candidateAddition struct {
 	AdditionalVendors  []string
 }
 
-// findAdditionalVendors searches all possible vendor additions that could be added during the CPE generation process (given package info + a vendor candidate)
-func findAdditionalVendors(allAdditions map[pkg.Type]map[candidateKey]candidateAddition, ty pkg.Type, pkgName, vendor string) (vendors []string) {
-	additions, ok := allAdditions[ty]
-	if !ok {
-		return nil
+// candidateFinder finds the candidate additions for a package type and the package info to match on.
+type candidateFinder interface {
+	find(ty pkg.Type, key candidateKey) (candidateAddition, bool)
+}
+
+func (s candidateStore) find(ty pkg.Type, key candidateKey) (candidateAddition, bool) {
+	addition, ok := s[ty][key]
+	return addition, ok
+}
+
+// productCandidateStore layers user supplied product candidates (by package type and package name) over the builtin
+// candidate additions. Additions for the same package type and name replace (not append to) the builtin additions.
+// Neither is copied, so there is no cost to using the store for every package.
+type productCandidateStore struct {
+	products map[pkg.Type]map[string][]string
+	builtin  candidateStore
+}
+
+func (s productCandidateStore) find(ty pkg.Type, key candidateKey) (candidateAddition, bool) {
+	if key.Vendor == "" {
+		if products, ok := s.products[ty][key.PkgName]; ok {
+			return candidateAddition{AdditionalProducts: products}, true
+		}
 	}
+	return s.builtin.find(ty, key)
+}
 
-	if addition, ok := additions[candidateKey{
+// findAdditionalVendors searches all possible vendor additions that could be added during the CPE generation process (given package info + a vendor candidate)
+func findAdditionalVendors(allAdditions candidateFinder, ty pkg.Type, pkgName, vendor string) (vendors []string) {
+	if addition, ok := allAdditions.find(ty, candidateKey{
 		Vendor:  vendor,
 		PkgName: pkgName,
-	}]; ok {
+	}); ok {
 		vendors = append(vendors, addition.AdditionalVendors...)
 	}
 
-	if addition, ok := additions[candidateKey{
+	if addition, ok := allAdditions.find(ty, candidateKey{
 		PkgName: pkgName,
-	}]; ok {
+	}); ok {
 		vendors = append(vendors, addition.AdditionalVendors...)
 	}
 
-	if addition, ok := additions[candidateKey{
+	if addition, ok := allAdditions.find(ty, candidateKey{
 		Vendor: vendor,
-	}]; ok {
+	}); ok {
 		vendors = append(vendors, addition.AdditionalVendors...)
 	}
 
@@ -178,15 +226,10 @@ func findAdditionalVendors(allAdditions map[pkg.Type]map[candidateKey]candidateA
 }
 
 // findAdditionalProducts searches all possible product additions that could be added during the CPE generation process (given package info)
-func findAdditionalProducts(allAdditions map[pkg.Type]map[candidateKey]candidateAddition, ty pkg.Type, pkgName string) (products []string) {
-	additions, ok := allAdditions[ty]
-	if !ok {
-		return nil
-	}
-
-	if addition, ok := additions[candidateKey{
+func findAdditionalProducts(allAdditions candidateFinder, ty pkg.Type, pkgName string) (products []string) {
+	if addition, ok := allAdditions.find(ty, candidateKey{
 		PkgName: pkgName,
-	}]; ok {
+	}); ok {
 		products = append(products, addition.AdditionalProducts...)
 	}
 
@@ -10,7 +10,7 @@ import (
 func Test_additionalProducts(t *testing.T) {
 	tests := []struct {
 		name         string
-		allAdditions map[pkg.Type]map[candidateKey]candidateAddition
+		allAdditions candidateStore
 		ty           pkg.Type
 		pkgName      string
 		expected     []string
@@ -56,7 +56,7 @@ func Test_additionalProducts(t *testing.T) {
 func Test_additionalVendors(t *testing.T) {
 	tests := []struct {
 		name         string
-		allAdditions map[pkg.Type]map[candidateKey]candidateAddition
+		allAdditions candidateStore
 		ty           pkg.Type
 		pkgName      string
 		vendor       string
@@ -153,3 +153,123 @@ func Test_additionalVendors(t *testing.T) {
 		})
 	}
 }
+
+func Test_productCandidateStore(t *testing.T) {
+	store := productCandidateStore{
+		products: map[pkg.Type]map[string][]string{
+			pkg.JavaPkg: {
+				"spring-core": {"spring"},
+			},
+			pkg.GemPkg: {
+				"acme-widgets": {"widgets"},
+			},
+		},
+		builtin: candidateStore{
+			pkg.JavaPkg: {
+				candidateKey{PkgName: "spring-core"}: {
+					AdditionalProducts: []string{"spring_framework"},
+					AdditionalVendors:  []string{"pivotal_software"},
+				},
+				candidateKey{PkgName: "log4j"}: {
+					AdditionalVendors: []string{"apache"},
+				},
+				candidateKey{Vendor: "spring-core"}: {
+					AdditionalVendors: []string{"vmware"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		ty       pkg.Type
+		key      candidateKey
+		expected candidateAddition
+		found    bool
+	}{
+		{
+			// the user supplied entry replaces the builtin entry entirely
+			name: "user supplied replaces builtin",
+			ty:   pkg.JavaPkg,
+			key:  candidateKey{PkgName: "spring-core"},
+			expected: candidateAddition{
+				AdditionalProducts: []string{"spring"},
+			},
+			found: true,
+		},
+		{
+			name: "builtin only",
+			ty:   pkg.JavaPkg,
+			key:  candidateKey{PkgName: "log4j"},
+			expected: candidateAddition{
+				AdditionalVendors: []string{"apache"},
+			},
+			found: true,
+		},
+		{
+			name: "vendor keys are only builtin",
+			ty:   pkg.JavaPkg,
+			key:  candidateKey{Vendor: "spring-core"},
+			expected: candidateAddition{
+				AdditionalVendors: []string{"vmware"},
+			},
+			found: true,
+		},
+		{
+			name: "user supplied only",
+			ty:   pkg.GemPkg,
+			key:  candidateKey{PkgName: "acme-widgets"},
+			expected: candidateAddition{
+				AdditionalProducts: []string{"widgets"},
+			},
+			found: true,
+		},
+		{
+			name: "missing",
+			ty:   pkg.GemPkg,
+			key:  candidateKey{PkgName: "rails"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, found := store.find(test.ty, test.key)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestGenerateWithConfig_ProductCandidates(t *testing.T) {
+	p := pkg.Package{
+		Name:     "acme-widgets",
+		Version:  "1.0",
+		Language: pkg.Ruby,
+		Type:     pkg.GemPkg,
+	}
+
+	cfg := Config{
+		ProductCandidates: map[pkg.Type]map[string][]string{
+			pkg.GemPkg: {
+				"acme-widgets": {"widgets"},
+			},
+		},
+	}
+
+	assert.NotContains(t, cpeStrings(GenerateWithConfig(p, Config{})), "cpe:2.3:a:acme:widgets:1.0:*:*:*:*:*:*:*")
+	assert.Contains(t, cpeStrings(GenerateWithConfig(p, cfg)), "cpe:2.3:a:acme:widgets:1.0:*:*:*:*:*:*:*")
+
+	// user supplied candidates replace the built-in candidates for the same package
+	rrdtool := pkg.Package{
+		Name:     "python-rrdtool",
+		Version:  "1.0",
+		Language: pkg.Python,
+		Type:     pkg.PythonPkg,
+	}
+	assert.Contains(t, candidateProducts(rrdtool, Config{}), "rrdtool")
+
+	cfg.ProductCandidates[pkg.PythonPkg] = map[string][]string{
+		"python-rrdtool": {"rrdtool_bindings"},
+	}
+	assert.NotContains(t, candidateProducts(rrdtool, cfg), "rrdtool")
+	assert.Contains(t, candidateProducts(rrdtool, cfg), "rrdtool_bindings")
+}
@@ -0,0 +1,108 @@
+package cpe
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/facebookincubator/nvdtools/wfn"
+)
+
+// ConfidenceTier indicates how likely it is that a generated CPE describes the package it was generated for.
+type ConfidenceTier int
+
+const (
+	// LowConfidence CPEs are guesses, such as those with a wildcard vendor or a variation of the package name.
+	LowConfidence ConfidenceTier = iota
+	// MediumConfidence CPEs are named after the package, but the vendor is not known to be authoritative.
+	MediumConfidence
+	// HighConfidence CPEs are curated or are derived from authoritative package metadata (e.g. the pom group and artifact ID).
+	HighConfidence
+)
+
+func (t ConfidenceTier) String() string {
+	switch t {
+	case HighConfidence:
+		return "high"
+	case MediumConfidence:
+		return "medium"
+	}
+	return "low"
+}
+
+// GenerateCPEsByTier is the same as Generate, however, the CPEs are grouped by how confident we are that each CPE
+// describes the given package. This allows for consumers to try the most confident CPEs first.
+func GenerateCPEsByTier(p pkg.Package) map[ConfidenceTier][]pkg.CPE {
+	p = relocateJavaPackage(p)
+
+	tiers := make(map[ConfidenceTier][]pkg.CPE)
+	for _, c := range Generate(p) {
+		tier := cpeConfidence(c, p)
+		tiers[tier] = append(tiers[tier], c)
+	}
+	return tiers
+}
+
+// cpeConfidence scores a single CPE generated for the given package.
+func cpeConfidence(c pkg.CPE, p pkg.Package) ConfidenceTier {
+	for _, pair := range authoritativeVendorProducts(p) {
+		if strings.EqualFold(c.Vendor, pair.vendor) && strings.EqualFold(c.Product, pair.product) {
+			return HighConfidence
+		}
+	}
+
+	if c.Vendor != wfn.Any && strings.EqualFold(c.Product, p.Name) {
+		return MediumConfidence
+	}
+
+	return LowConfidence
+}
+
+// authoritativeVendorProducts returns the vendor/product pairs for the package that are either curated or are read
+// from metadata fields that directly describe the owner and name of the project.
+func authoritativeVendorProducts(p pkg.Package) []vendorProduct {
+	pairs := knownSoftwareVendorProducts(p)
+
+	for _, product := range findAdditionalProducts(defaultCandidateAdditions, p.Type, p.Name) {
+		for _, vendor := range findAdditionalVendors(defaultCandidateAdditions, p.Type, p.Name, "") {
+			pairs = append(pairs, vendorProduct{vendor: vendor, product: product})
+		}
+	}
+
+	switch {
+	case p.MetadataType == pkg.JavaMetadataType:
+		pairs = append(pairs, javaPomVendorProducts(p)...)
+	case p.Language == pkg.Go:
+		if vendor, product := candidateVendorForGo(p.Name), candidateProductForGo(p.Name); vendor != "" && product != "" {
+			pairs = append(pairs, vendorProduct{vendor: vendor, product: product})
+		}
+	}
+
+	return pairs
+}
+
+// javaPomVendorProducts returns the organization from the pom group ID (e.g. google from com.google.guava) paired
+// with the pom artifact ID.
+func javaPomVendorProducts(p pkg.Package) (pairs []vendorProduct) {
+	metadata, ok := p.Metadata.(pkg.JavaMetadata)
+	if !ok {
+		return nil
+	}
+
+	var coordinates [][2]string
+	if metadata.PomProperties != nil {
+		coordinates = append(coordinates, [2]string{metadata.PomProperties.GroupID, metadata.PomProperties.ArtifactID})
+	}
+	if metadata.PomProject != nil {
+		coordinates = append(coordinates, [2]string{metadata.PomProject.GroupID, metadata.PomProject.ArtifactID})
+	}
+
+	for _, coordinate := range coordinates {
+		groupID, artifactID := cleanGroupID(coordinate[0]), strings.TrimSpace(coordinate[1])
+		fields := strings.Split(groupID, ".")
+		if !startsWithTopLevelDomain(groupID) || len(fields) < 2 || artifactID == "" {
+			continue
+		}
+		pairs = append(pairs, vendorProduct{vendor: fields[1], product: artifactID})
+	}
+	return pairs
+}
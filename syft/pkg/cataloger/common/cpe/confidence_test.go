@@ -0,0 +1,68 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateCPEsByTier(t *testing.T) {
+	p := pkg.Package{
+		Name:         "guava",
+		Version:      "31.1",
+		Language:     pkg.Java,
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			PomProperties: &pkg.PomProperties{
+				GroupID:    "com.google.guava",
+				ArtifactID: "guava",
+				Version:    "31.1",
+			},
+		},
+	}
+
+	tiers := GenerateCPEsByTier(p)
+
+	assert.Equal(t, []string{"cpe:2.3:a:google:guava:31.1:*:*:*:*:*:*:*"}, cpeStrings(tiers[HighConfidence]))
+	assert.Contains(t, cpeStrings(tiers[MediumConfidence]), "cpe:2.3:a:guava:guava:31.1:*:*:*:*:*:*:*")
+
+	var total int
+	for _, cpes := range tiers {
+		total += len(cpes)
+	}
+	assert.Equal(t, len(Generate(p)), total)
+}
+
+func Test_cpeConfidence(t *testing.T) {
+	p := pkg.Package{
+		Name:     "lodash",
+		Version:  "4.17.21",
+		Language: pkg.JavaScript,
+		Type:     pkg.NpmPkg,
+	}
+
+	tests := []struct {
+		cpe      string
+		expected ConfidenceTier
+	}{
+		{
+			cpe:      "cpe:2.3:a:lodash:lodash:4.17.21:*:*:*:*:*:*:*",
+			expected: MediumConfidence,
+		},
+		{
+			cpe:      "cpe:2.3:a:*:lodash:4.17.21:*:*:*:*:*:*:*",
+			expected: LowConfidence,
+		},
+		{
+			cpe:      "cpe:2.3:a:lodash:lodash_js:4.17.21:*:*:*:*:*:*:*",
+			expected: LowConfidence,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.cpe, func(t *testing.T) {
+			assert.Equal(t, test.expected, cpeConfidence(pkg.MustCPE(test.cpe), p))
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package cpe
+
+import (
+	"regexp"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// Config holds the options that tune how CPEs are generated for a package.
+type Config struct {
+	// MaxSubSelections limits the number of sub-selections generated from a single field candidate
+	// (e.g. a-b-c-d -> [a, a-b, a-b-c]). Candidates with more sub-selections than the limit only keep the first token and
+	// the full field. A value of zero uses the default limit (8), and a negative value means there is no limit.
+	MaxSubSelections int
+
+	// VersionUpdateFromSuffix moves any service pack or update suffix from the version into the update field
+	// (e.g. 1.2.3-sp1 -> version=1.2.3 update=sp1).
+	VersionUpdateFromSuffix bool
+
+	// IncludeReleaseVersion additionally generates CPEs with the release version for any pre-release version
+	// (e.g. 1.2.3-beta -> [1.2.3-beta, 1.2.3]), since NVD entries are not consistent in which is used.
+	IncludeReleaseVersion bool
+
+	// IncludeThreePartVersion additionally generates CPEs with only the first three components of any four-part version
+	// (e.g. 1.2.3.4 -> [1.2.3.4, 1.2.3]), which is common for windows binaries while NVD tends to use three parts.
+	IncludeThreePartVersion bool
+
+	// IncludeAnyVersion additionally generates a version-agnostic CPE (version=*) for every vendor/product pair, which
+	// is useful for catching vulnerabilities where the affected version ranges in NVD are imprecise.
+	IncludeAnyVersion bool
+
+	// IncludeRawVersion additionally generates CPEs with the package version as-is whenever it differs from the
+	// normalized version (e.g. v2.0.0+incompatible -> [2.0.0, v2.0.0+incompatible]), for matchers that need to compare
+	// against the raw version.
+	IncludeRawVersion bool
+
+	// PreserveCase keeps the casing found in the package metadata for all CPE attributes. By default all attributes are
+	// lowercased to match the casing used by NVD.
+	PreserveCase bool
+
+	// IncludeASCIIFolded additionally uses ASCII-folded variants of vendor and product candidates that contain unicode
+	// characters (e.g. café -> cafe), since NVD only uses ASCII for these fields.
+	IncludeASCIIFolded bool
+
+	// IncludeRoleProducts additionally generates products for packages whose name indicates a server or client role,
+	// since NVD tends to track these as separate products owned by the project (e.g. postgresql14-server ->
+	// postgresql:postgresql and postgresql:postgresql_server).
+	IncludeRoleProducts bool
+
+	// StripLocaleSuffixes additionally generates products without any recognized 2-letter language or country code
+	// suffix, which is used to name localized builds of a package (e.g. calendar-en -> [calendar-en, calendar]).
+	StripLocaleSuffixes bool
+
+	// IncludeVendorProductConcatenations additionally generates products that join each vendor and product candidate
+	// with and without a hyphen, since NVD sometimes lists a project under a product that includes the vendor name
+	// (e.g. mongo + db -> [mongodb, mongo-db]).
+	IncludeVendorProductConcatenations bool
+
+	// IncludePURLCandidates additionally uses the vendor and product described by the package URL of the package (when
+	// set) as candidates (e.g. pkg:maven/org.apache.logging.log4j/log4j-core -> apache:log4j-core).
+	IncludePURLCandidates bool
+
+	// IncludeLanguage additionally generates CPEs with the language attribute populated for packages whose name indicates
+	// a localized build (e.g. calendar-en -> language=en), alongside the CPEs that match any language.
+	IncludeLanguage bool
+
+	// IncludeTargetSoftware additionally generates CPEs with the target software that NVD uses for the platform the
+	// package is built for (e.g. .net_framework), alongside the CPEs that match any target software.
+	IncludeTargetSoftware bool
+
+	// ProductCandidates are additional product candidates by package type and package name (e.g. gem -> acme-widgets
+	// -> [widgets]). Entries replace the built-in candidate additions for the same package type and name.
+	ProductCandidates map[pkg.Type]map[string][]string
+
+	// VendorAliases maps product candidates to additional vendor candidates (e.g. jetty -> [eclipse]). Entries replace
+	// the built-in aliases for the same product. Note that the built-in aliases are only applied to java and OS
+	// packages, where configured entries are applied to all packages.
+	VendorAliases map[string][]string
+
+	// VendorSeparatorPreference, when set, keeps only a single canonical form of vendor candidates that differ only by
+	// separators (e.g. jenkins-ci, jenkins_ci, jenkinsci). The first separator in the list present in a variant wins
+	// (e.g. ["-", "_", ""] prefers hyphens, then underscores, then no separator at all).
+	VendorSeparatorPreference []string
+
+	// PrivateNamespaces are package name (or java group ID) prefixes of private packages that should never have CPEs
+	// generated (e.g. "@mycompany/" or "com.mycompany.").
+	PrivateNamespaces []string
+
+	// PrivateNamespacePatterns are the same as PrivateNamespaces, however, expressed as patterns matched against the
+	// package name (or java group ID).
+	PrivateNamespacePatterns []*regexp.Regexp
+
+	// Filters are additional filters that remove known false positives, which are applied after (and never replace) the
+	// built-in filters (see ReadFilters).
+	Filters []FilterFunc
+
+	// IncludeGoToolchain additionally generates a CPE for the go toolchain that compiled a go binary
+	// (e.g. go1.20.3 -> cpe:2.3:a:golang:go:1.20.3).
+	IncludeGoToolchain bool
+
+	// PostProcess, when set, is called with the generated CPEs for a package as the final step of generation, allowing
+	// for CPEs to be rewritten, removed, or added arbitrarily. The returned CPEs are sorted by specificity.
+	PostProcess func([]pkg.CPE, pkg.Package) []pkg.CPE
+
+	// Observer, when set, is called with the candidate and CPE counts for each package CPEs are generated for. This is
+	// intended for debugging poor matches and does not affect the generated CPEs.
+	Observer func(pkg.Package, Metrics)
+
+	// ExcludeProductVendors stops product candidates from also being used as vendor candidates. By default the project
+	// name is a stand-in for the vendor (e.g. the rack gem -> rack:rack), which is correct for many projects, however,
+	// is a large source of noise for packages that carry enough metadata to find the vendor otherwise.
+	ExcludeProductVendors bool
+
+	// KeepCuratedProducts exempts CPEs with a curated product for the package (the built-in or configured product
+	// candidates and the well-known software pairs) from the filters that remove known false positives, so these
+	// products are always present. Private namespaces are still excluded.
+	KeepCuratedProducts bool
+
+	// ExcludeSubSelections stops vendor candidates from being split into sub-selections on separators
+	// (e.g. jenkins-ci -> [jenkins, jenkins-ci]).
+	ExcludeSubSelections bool
+
+	// ExcludeDelimiterVariations stops hyphens and underscores from being swapped to create additional vendor and
+	// product candidates (e.g. jenkins-ci -> jenkins_ci).
+	ExcludeDelimiterVariations bool
+}
+
+// defaultMaxSubSelections is the limit on sub-selections per field candidate when Config.MaxSubSelections is not set
+const defaultMaxSubSelections = 8
+
+// Preset is a named set of options that trade off the number of CPEs generated for a package against the chance that
+// the CPE NVD uses for the package is among them.
+type Preset string
+
+const (
+	// GreedyPreset uses all candidate heuristics, which is the default behavior.
+	GreedyPreset Preset = "greedy"
+
+	// ConservativePreset only uses the package name, the package metadata, and the curated candidates, without any of
+	// the heuristics that derive additional candidates from the name.
+	ConservativePreset Preset = "conservative"
+)
+
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// maxSubSelections returns the limit on sub-selections per field candidate, which is the default limit (8) when not set.
+// A negative limit means there is no limit.
+func (c Config) maxSubSelections() int {
+	if c.MaxSubSelections == 0 {
+		return defaultMaxSubSelections
+	}
+	return c.MaxSubSelections
+}
+
+// WithPreset returns the config with the options for the given preset applied, leaving all unrelated options as-is. An
+// unknown preset leaves the config unchanged.
+func (c Config) WithPreset(preset Preset) Config {
+	switch preset {
+	case GreedyPreset:
+		c.ExcludeProductVendors = false
+		c.ExcludeSubSelections = false
+		c.ExcludeDelimiterVariations = false
+	case ConservativePreset:
+		c.ExcludeProductVendors = true
+		c.ExcludeSubSelections = true
+		c.ExcludeDelimiterVariations = true
+	}
+	return c
+}
+
+// candidateAdditions returns the candidate additions to use, which are any configured product candidates layered over the
+// built-in additions.
+func (c Config) candidateAdditions() candidateFinder {
+	if len(c.ProductCandidates) == 0 {
+		return defaultCandidateAdditions
+	}
+	return productCandidateStore{products: c.ProductCandidates, builtin: defaultCandidateAdditions}
+}
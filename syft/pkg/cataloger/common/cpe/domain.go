@@ -0,0 +1,54 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/scylladb/go-set/strset"
+)
+
+// domainTLDs are the top level domains considered when detecting names that are domains. Note: pseudo-TLDs that are
+// commonly part of a product name in NVD are deliberately absent (e.g. "js" in node.js or chart.js).
+var domainTLDs = strset.New("com", "org", "net", "io", "dev", "app", "se", "de", "uk", "fr", "nl", "ch", "cc", "me", "co")
+
+// domainNamedPackageTypes are the package types whose names may be a homepage domain. Language ecosystems use dotted
+// names as namespaces (e.g. System.Net or ruamel.yaml), which would otherwise be mistaken for domains.
+var domainNamedPackageTypes = map[pkg.Type]bool{
+	"":             true,
+	pkg.UnknownPkg: true,
+	pkg.AlpmPkg:    true,
+	pkg.ApkPkg:     true,
+	pkg.DebPkg:     true,
+	pkg.PortagePkg: true,
+	pkg.RpmPkg:     true,
+}
+
+var domainLabelPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// candidateProductsFromDomain returns product candidates for a package that is named after its homepage domain, which
+// is the second level domain and the name without the top level domain (e.g. curl.se -> curl, or
+// docs.example.io -> [example, docs.example]). Only OS and binary packages are considered.
+func candidateProductsFromDomain(p pkg.Package) []string {
+	if !domainNamedPackageTypes[p.Type] {
+		return nil
+	}
+
+	labels := strings.Split(strings.TrimPrefix(strings.ToLower(p.Name), "www."), ".")
+	if len(labels) < 2 || !domainTLDs.Has(labels[len(labels)-1]) {
+		return nil
+	}
+
+	for _, label := range labels {
+		if !domainLabelPattern.MatchString(label) {
+			return nil
+		}
+	}
+
+	sld := labels[len(labels)-2]
+	withoutTLD := strings.Join(labels[:len(labels)-1], ".")
+	if sld == withoutTLD {
+		return []string{sld}
+	}
+	return []string{sld, withoutTLD}
+}
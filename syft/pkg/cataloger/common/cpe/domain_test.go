@@ -0,0 +1,70 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_candidateProductsFromDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkgType  pkg.Type
+		expected []string
+	}{
+		{
+			name:     "curl.se",
+			expected: []string{"curl"},
+		},
+		{
+			name:     "www.example.com",
+			expected: []string{"example"},
+		},
+		{
+			name:     "docs.example.io",
+			expected: []string{"example", "docs.example"},
+		},
+		{
+			name:     "chart.js",
+			expected: nil,
+		},
+		{
+			name:     "zope.interface",
+			expected: nil,
+		},
+		{
+			name:     "curl",
+			expected: nil,
+		},
+		{
+			name:     "bad_label.com",
+			expected: nil,
+		},
+		{
+			name:     "curl.se",
+			pkgType:  pkg.ApkPkg,
+			expected: []string{"curl"},
+		},
+		{
+			name:     "System.Net",
+			pkgType:  pkg.DotnetPkg,
+			expected: nil,
+		},
+		{
+			name:     "runtime.linux-x64.Microsoft.NETCore.App",
+			pkgType:  pkg.DotnetPkg,
+			expected: nil,
+		},
+		{
+			name:     "example.com",
+			pkgType:  pkg.PythonPkg,
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, candidateProductsFromDomain(pkg.Package{Name: test.name, Type: test.pkgType}))
+		})
+	}
+}
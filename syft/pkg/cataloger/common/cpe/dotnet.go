@@ -0,0 +1,102 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/scylladb/go-set/strset"
+)
+
+// dotnetRuntimePackagePattern matches NuGet packages that carry the runtime specific assets of another package, which are
+// named after that package with a "runtime.<rid>." prefix (e.g. runtime.linux-x64.Microsoft.NETCore.App).
+var dotnetRuntimePackagePattern = regexp.MustCompile(`^runtime\.(?:any|unix|win|linux|linux-musl|osx|alpine|android|ios|freebsd|debian|ubuntu|rhel|centos|fedora|opensuse|sles|ol|tizen)[0-9]*(?:\.[0-9]+)*(?:-[a-z0-9]+)*\.(?P<name>.+)$`)
+
+// dotnetGenericSegments are trailing NuGet name segments that describe a feature area rather than a product, which NVD
+// only uses as part of a longer product name (e.g. Newtonsoft.Json is newtonsoft_json, not json).
+var dotnetGenericSegments = strset.New(
+	"abstractions", "app", "client", "collections", "common", "configuration", "core", "data", "extensions", "http",
+	"json", "logging", "net", "primitives", "runtime", "serialization", "text", "web", "xml",
+)
+
+// trimDotnetRuntimePrefix returns the name of the package the given runtime package provides assets for
+// (e.g. runtime.linux-x64.Microsoft.NETCore.App -> Microsoft.NETCore.App), or an empty string if it is not a runtime
+// package.
+func trimDotnetRuntimePrefix(name string) string {
+	match := dotnetRuntimePackagePattern.FindStringSubmatch(name)
+	if match == nil {
+		return ""
+	}
+	return match[dotnetRuntimePackagePattern.SubexpIndex("name")]
+}
+
+// dotnetNameSegments returns the dot separated segments of the given NuGet package name, which by convention start with
+// the owning organization (e.g. Newtonsoft.Json -> [Newtonsoft, Json]). Runtime packages are described by the package
+// they provide assets for.
+func dotnetNameSegments(p pkg.Package) []string {
+	if p.Type != pkg.DotnetPkg {
+		return nil
+	}
+
+	name := p.Name
+	if trimmed := trimDotnetRuntimePrefix(name); trimmed != "" {
+		name = trimmed
+	}
+
+	segments := strings.Split(name, ".")
+	if len(segments) < 2 {
+		return nil
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return nil
+		}
+	}
+	return segments
+}
+
+// candidateVendorsForDotnet returns the organization that owns the given NuGet package (e.g. Newtonsoft.Json -> newtonsoft).
+func candidateVendorsForDotnet(p pkg.Package) []string {
+	segments := dotnetNameSegments(p)
+	if segments == nil {
+		return nil
+	}
+	return []string{strings.ToLower(segments[0])}
+}
+
+// candidateProductsForDotnet returns the product names NVD uses for the given NuGet package, which is either the last
+// segment of the name or the whole name joined with underscores, with and without the owning organization
+// (e.g. Microsoft.AspNetCore.Mvc -> [mvc, microsoft_aspnetcore_mvc, aspnetcore_mvc]). Generic last segments are only
+// used as part of the whole name (e.g. Newtonsoft.Json -> [newtonsoft_json]).
+func candidateProductsForDotnet(p pkg.Package) []string {
+	segments := dotnetNameSegments(p)
+	if segments == nil {
+		return nil
+	}
+
+	var candidates []string
+	if last := segments[len(segments)-1]; !dotnetGenericSegments.Has(strings.ToLower(last)) {
+		candidates = append(candidates, last)
+	}
+	candidates = append(candidates, strings.Join(segments, "_"))
+	if len(segments) > 2 {
+		candidates = append(candidates, strings.Join(segments[1:], "_"))
+	}
+
+	// PascalCase is never used for product names in NVD
+	for i, candidate := range candidates {
+		candidates[i] = strings.ToLower(candidate)
+	}
+	return candidates
+}
+
+// isASPNetPackage indicates if the given NuGet package is part of (or an extension to) ASP.NET
+// (e.g. Microsoft.AspNetCore.Mvc or Microsoft.AspNet.WebApi).
+func isASPNetPackage(p pkg.Package) bool {
+	for _, segment := range dotnetNameSegments(p) {
+		if strings.HasPrefix(strings.ToLower(segment), "aspnet") {
+			return true
+		}
+	}
+	return false
+}
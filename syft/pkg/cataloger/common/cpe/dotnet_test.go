@@ -0,0 +1,162 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_trimDotnetRuntimePrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "runtime.linux-x64.Microsoft.NETCore.App",
+			expected: "Microsoft.NETCore.App",
+		},
+		{
+			name:     "runtime.win-x86.Microsoft.NETCore.DotNetAppHost",
+			expected: "Microsoft.NETCore.DotNetAppHost",
+		},
+		{
+			name:     "runtime.ubuntu.18.04-x64.runtime.native.System.Security.Cryptography.OpenSsl",
+			expected: "runtime.native.System.Security.Cryptography.OpenSsl",
+		},
+		{
+			name:     "runtime.unix.System.Net.Sockets",
+			expected: "System.Net.Sockets",
+		},
+		{
+			// "native" is not a runtime identifier
+			name:     "runtime.native.System",
+			expected: "",
+		},
+		{
+			name:     "Microsoft.NETCore.App",
+			expected: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, trimDotnetRuntimePrefix(test.name))
+		})
+	}
+}
+
+func Test_candidateProductsForDotnet(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "organization and product",
+			p: pkg.Package{
+				Name: "Newtonsoft.Json",
+				Type: pkg.DotnetPkg,
+			},
+			expected: []string{"newtonsoft_json"},
+		},
+		{
+			name: "organization, project, and product",
+			p: pkg.Package{
+				Name: "Microsoft.AspNetCore.Mvc",
+				Type: pkg.DotnetPkg,
+			},
+			expected: []string{"mvc", "microsoft_aspnetcore_mvc", "aspnetcore_mvc"},
+		},
+		{
+			name: "runtime package",
+			p: pkg.Package{
+				Name: "runtime.linux-x64.Microsoft.NETCore.App",
+				Type: pkg.DotnetPkg,
+			},
+			expected: []string{"microsoft_netcore_app", "netcore_app"},
+		},
+		{
+			name: "single segment",
+			p: pkg.Package{
+				Name: "Dapper",
+				Type: pkg.DotnetPkg,
+			},
+		},
+		{
+			name: "not a dotnet package",
+			p: pkg.Package{
+				Name: "ruamel.yaml",
+				Type: pkg.PythonPkg,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, candidateProductsForDotnet(test.p))
+		})
+	}
+}
+
+func Test_candidateProducts_dotnetNamesAreNotDomains(t *testing.T) {
+	for _, name := range []string{"runtime.linux-x64.Microsoft.NETCore.App", "System.Net", "Newtonsoft.Json"} {
+		t.Run(name, func(t *testing.T) {
+			p := pkg.Package{Name: name, Type: pkg.DotnetPkg}
+			assert.Empty(t, candidateProductsFromDomain(p))
+
+			products := candidateProducts(p, DefaultConfig())
+			assert.NotContains(t, products, "netcore")
+			assert.NotContains(t, products, "system")
+		})
+	}
+}
+
+func TestGenerateWithConfig_Dotnet(t *testing.T) {
+	p := pkg.Package{
+		Name:    "Microsoft.AspNetCore.Mvc",
+		Version: "2.2.0",
+		Type:    pkg.DotnetPkg,
+	}
+
+	cpes := cpeStrings(GenerateWithConfig(p, Config{IncludeTargetSoftware: true}))
+	assert.Contains(t, cpes, "cpe:2.3:a:microsoft:aspnetcore_mvc:2.2.0:*:*:*:*:*:*:*")
+	assert.Contains(t, cpes, "cpe:2.3:a:microsoft:aspnetcore_mvc:2.2.0:*:*:*:*:asp.net:*:*")
+	assert.Contains(t, cpes, "cpe:2.3:a:microsoft:mvc:2.2.0:*:*:*:*:.net:*:*")
+	assert.Contains(t, cpes, "cpe:2.3:a:microsoft:mvc:2.2.0:*:*:*:*:dotnet:*:*")
+}
+
+func TestGenerate_DotnetGenericSegments(t *testing.T) {
+	p := pkg.Package{
+		Name:    "Newtonsoft.Json",
+		Version: "13.0.1",
+		Type:    pkg.DotnetPkg,
+	}
+
+	cpes := cpeStrings(Generate(p))
+	assert.Contains(t, cpes, "cpe:2.3:a:newtonsoft:newtonsoft_json:13.0.1:*:*:*:*:*:*:*")
+	assert.NotContains(t, cpes, "cpe:2.3:a:json:json:13.0.1:*:*:*:*:*:*:*")
+	assert.NotContains(t, cpes, "cpe:2.3:a:newtonsoft:json:13.0.1:*:*:*:*:*:*:*")
+}
+
+func TestGenerateWithConfig_IncludeTargetSoftware(t *testing.T) {
+	p := pkg.Package{
+		Name:         "Widget",
+		Version:      "1.0.0",
+		Language:     pkg.Dotnet,
+		Type:         pkg.DotnetPkg,
+		MetadataType: pkg.DotnetDepsMetadataType,
+		Metadata: pkg.DotnetDepsMetadata{
+			Name:            "Widget",
+			Version:         "1.0.0",
+			TargetFramework: ".NETFramework,Version=v4.7.2",
+		},
+	}
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:widget:widget:1.0.0:*:*:*:*:*:*:*",
+	}, cpeStrings(GenerateWithConfig(p, Config{})))
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:widget:widget:1.0.0:*:*:*:*:*:*:*",
+		"cpe:2.3:a:widget:widget:1.0.0:*:*:*:*:.net_framework:*:*",
+	}, cpeStrings(GenerateWithConfig(p, Config{IncludeTargetSoftware: true})))
+}
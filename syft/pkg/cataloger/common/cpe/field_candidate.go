@@ -2,8 +2,6 @@ package cpe
 
 import (
 	"strconv"
-
-	"github.com/scylladb/go-set/strset"
 )
 
 // fieldCandidate represents a single "guess" for a specific field in a future CPE (vendor, product, target SW, etc).
@@ -20,9 +18,7 @@ type fieldCandidateSet map[fieldCandidate]struct{}
 
 func newFieldCandidateSetFromSets(sets ...fieldCandidateSet) fieldCandidateSet {
 	s := newFieldCandidateSet()
-	for _, set := range sets {
-		s.add(set.list()...)
-	}
+	s.union(sets...)
 	return s
 }
 
@@ -72,11 +68,18 @@ func (s fieldCandidateSet) clear() {
 
 func (s fieldCandidateSet) union(others ...fieldCandidateSet) {
 	for _, other := range others {
-		s.add(other.list()...)
+		for candidate := range other {
+			s.add(candidate)
+		}
 	}
 }
 
-func (s fieldCandidateSet) list() (results []fieldCandidate) {
+func (s fieldCandidateSet) list() []fieldCandidate {
+	if len(s) == 0 {
+		return nil
+	}
+
+	results := make([]fieldCandidate, 0, len(s))
 	for c := range s {
 		results = append(results, c)
 	}
@@ -84,8 +87,13 @@ func (s fieldCandidateSet) list() (results []fieldCandidate) {
 	return results
 }
 
-func (s fieldCandidateSet) values() (results []string) {
-	for _, c := range s.list() {
+func (s fieldCandidateSet) values() []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	results := make([]string, 0, len(s))
+	for c := range s {
 		results = append(results, c.value)
 	}
 
@@ -93,12 +101,26 @@ func (s fieldCandidateSet) values() (results []string) {
 }
 
 func (s fieldCandidateSet) uniqueValues() []string {
-	return strset.New(s.values()...).List()
+	// candidates may share a value (differing only by the transforms allowed), so dedup in the same pass as collection
+	seen := make(map[string]struct{}, len(s))
+	results := make([]string, 0, len(s))
+	for c := range s {
+		if _, ok := seen[c.value]; ok {
+			continue
+		}
+		seen[c.value] = struct{}{}
+		results = append(results, c.value)
+	}
+
+	return results
 }
 
 func (s fieldCandidateSet) copy() fieldCandidateSet {
-	newSet := newFieldCandidateSet()
-	newSet.add(s.list()...)
+	// all values within the set have already been cleaned
+	newSet := make(fieldCandidateSet, len(s))
+	for c := range s {
+		newSet[c] = struct{}{}
+	}
 
 	return newSet
 }
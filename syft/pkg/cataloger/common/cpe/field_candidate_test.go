@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/scylladb/go-set/strset"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -284,6 +285,37 @@ func Test_cpeFieldCandidateSet_uniqueValues(t *testing.T) {
 
 }
 
+func Test_cpeFieldCandidateSet_parity(t *testing.T) {
+	set := newFieldCandidateSet("a-b", "a_b", "c")
+	set.add(
+		fieldCandidate{
+			value:                 "a-b",
+			disallowSubSelections: true,
+		},
+		fieldCandidate{
+			value:                       "d",
+			disallowDelimiterVariations: true,
+		},
+	)
+
+	// the results must match the (allocation heavy) implementations these methods replaced
+	var values []string
+	for _, c := range set.list() {
+		values = append(values, c.value)
+	}
+	assert.ElementsMatch(t, values, set.values())
+	assert.ElementsMatch(t, strset.New(values...).List(), set.uniqueValues())
+
+	expectedCopy := newFieldCandidateSet()
+	expectedCopy.add(set.list()...)
+	assert.Equal(t, expectedCopy, set.copy())
+
+	expectedUnion := newFieldCandidateSet()
+	expectedUnion.add(set.list()...)
+	expectedUnion.add(expectedCopy.list()...)
+	assert.Equal(t, expectedUnion, newFieldCandidateSetFromSets(set, expectedCopy))
+}
+
 func Test_cpeFieldCandidateSet_removeByValue(t *testing.T) {
 	s := newFieldCandidateSet()
 
@@ -1,29 +1,34 @@
 package cpe
 
 import (
+	"regexp"
 	"strings"
 
+	"github.com/anchore/syft/syft/linux"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/facebookincubator/nvdtools/wfn"
 )
 
 const jenkinsName = "jenkins"
 
-// filterFn instances should return true if the given CPE should be removed from a collection for the given package
-type filterFn func(cpe pkg.CPE, p pkg.Package) bool
+// FilterFunc instances should return true if the given CPE should be removed from a collection for the given package.
+// The distro the package was found on is provided for filters that only apply to specific distros, which is nil when
+// unknown. Additional filters may be given with Config.Filters.
+type FilterFunc func(cpe pkg.CPE, p pkg.Package, d *linux.Release) bool
 
-var cpeFilters = []filterFn{
+var cpeFilters = []FilterFunc{
 	disallowJiraClientServerMismatch,
 	disallowJenkinsServerCPEForPluginPackage,
 	disallowJenkinsCPEsNotAssociatedWithJenkins,
+	disallowLog4jAPIMatchingLog4jCore,
 	disallowNonParseableCPEs,
 }
 
-func filter(cpes []pkg.CPE, p pkg.Package, filters ...filterFn) (result []pkg.CPE) {
+func filter(cpes []pkg.CPE, p pkg.Package, d *linux.Release, filters ...FilterFunc) (result []pkg.CPE) {
 cpeLoop:
 	for _, cpe := range cpes {
 		for _, fn := range filters {
-			if fn(cpe, p) {
+			if fn(cpe, p, d) {
 				continue cpeLoop
 			}
 		}
@@ -33,7 +38,7 @@ cpeLoop:
 	return result
 }
 
-func disallowNonParseableCPEs(cpe pkg.CPE, _ pkg.Package) bool {
+func disallowNonParseableCPEs(cpe pkg.CPE, _ pkg.Package, _ *linux.Release) bool {
 	v := pkg.CPEString(cpe)
 	_, err := pkg.NewCPE(v)
 
@@ -43,7 +48,7 @@ func disallowNonParseableCPEs(cpe pkg.CPE, _ pkg.Package) bool {
 }
 
 // jenkins plugins should not match against jenkins
-func disallowJenkinsServerCPEForPluginPackage(cpe pkg.CPE, p pkg.Package) bool {
+func disallowJenkinsServerCPEForPluginPackage(cpe pkg.CPE, p pkg.Package, _ *linux.Release) bool {
 	if p.Type == pkg.JenkinsPluginPkg && cpe.Product == jenkinsName {
 		return true
 	}
@@ -51,7 +56,7 @@ func disallowJenkinsServerCPEForPluginPackage(cpe pkg.CPE, p pkg.Package) bool {
 }
 
 // filter to account that packages that are not for jenkins but have a CPE generated that will match against jenkins
-func disallowJenkinsCPEsNotAssociatedWithJenkins(cpe pkg.CPE, p pkg.Package) bool {
+func disallowJenkinsCPEsNotAssociatedWithJenkins(cpe pkg.CPE, p pkg.Package, _ *linux.Release) bool {
 	// jenkins server should only match against a product with the name jenkins
 	if cpe.Product == jenkinsName && !strings.Contains(strings.ToLower(p.Name), jenkinsName) {
 		if cpe.Vendor == wfn.Any || cpe.Vendor == jenkinsName || cpe.Vendor == "cloudbees" {
@@ -62,7 +67,7 @@ func disallowJenkinsCPEsNotAssociatedWithJenkins(cpe pkg.CPE, p pkg.Package) boo
 }
 
 // filter to account for packages which are jira client packages but have a CPE that will match against jira
-func disallowJiraClientServerMismatch(cpe pkg.CPE, p pkg.Package) bool {
+func disallowJiraClientServerMismatch(cpe pkg.CPE, p pkg.Package, _ *linux.Release) bool {
 	// jira / atlassian should not apply to clients
 	if cpe.Product == "jira" && strings.Contains(strings.ToLower(p.Name), "client") {
 		if cpe.Vendor == wfn.Any || cpe.Vendor == "jira" || cpe.Vendor == "atlassian" {
@@ -71,3 +76,68 @@ func disallowJiraClientServerMismatch(cpe pkg.CPE, p pkg.Package) bool {
 	}
 	return false
 }
+
+// filter to account for the log4j-api package having a CPE that will match against the log4j (core) product, which is
+// the product that NVD uses for vulnerabilities in log4j-core (e.g. log4shell) that do not affect the api artifact
+func disallowLog4jAPIMatchingLog4jCore(cpe pkg.CPE, p pkg.Package, _ *linux.Release) bool {
+	if p.Name != "log4j-api" || cpe.Product != "log4j" {
+		return false
+	}
+	// allow for CPEs that clearly indicate the api artifact (e.g. vendor log4j-api)
+	return !strings.Contains(strings.ToLower(cpe.Vendor), "api")
+}
+
+// exemptProducts wraps the given filters so that CPEs for any of the given products are never removed.
+func exemptProducts(products []string, filters ...FilterFunc) []FilterFunc {
+	if len(products) == 0 {
+		return filters
+	}
+
+	exempt := func(cpe pkg.CPE) bool {
+		for _, product := range products {
+			if strings.EqualFold(cpe.Product, product) {
+				return true
+			}
+		}
+		return false
+	}
+
+	wrapped := make([]FilterFunc, 0, len(filters))
+	for _, fn := range filters {
+		fn := fn
+		wrapped = append(wrapped, func(cpe pkg.CPE, p pkg.Package, d *linux.Release) bool {
+			return !exempt(cpe) && fn(cpe, p, d)
+		})
+	}
+	return wrapped
+}
+
+// isPrivatePackage indicates if the given package is under any of the configured private namespaces, since CPEs for
+// packages that are never published cannot be meaningfully matched.
+func isPrivatePackage(p pkg.Package, cfg Config) bool {
+	if len(cfg.PrivateNamespaces) == 0 && len(cfg.PrivateNamespacePatterns) == 0 {
+		return false
+	}
+
+	names := append([]string{p.Name}, GroupIDsFromJavaPackage(p)...)
+	for _, name := range names {
+		if isPrivateNamespace(name, cfg.PrivateNamespaces, cfg.PrivateNamespacePatterns) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrivateNamespace(name string, prefixes []string, patterns []*regexp.Regexp) bool {
+	for _, prefix := range prefixes {
+		if prefix != "" && strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	for _, pattern := range patterns {
+		if pattern != nil && pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package cpe
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/syft/syft/linux"
+	"github.com/anchore/syft/syft/pkg"
+	"gopkg.in/yaml.v2"
+)
+
+// FilterRule describes CPEs that are known false positives with simple conditions, all of which must match for a CPE to
+// be removed. Conditions that are not set match any CPE.
+type FilterRule struct {
+	Product             string `yaml:"product" json:"product"`                             // the CPE product equals this value
+	Vendor              string `yaml:"vendor" json:"vendor"`                               // the CPE vendor equals this value
+	PackageNameContains string `yaml:"package-name-contains" json:"package-name-contains"` // the package name contains this value
+}
+
+// ReadFilters reads filter rules (for Config.Filters) from a YAML or JSON list of FilterRule values. For example:
+//
+//	# the acme widgets packages are not the widgets project
+//	- product: widgets
+//	  package-name-contains: acme-
+//
+// All comparisons are case-insensitive. Rules without any conditions are rejected, since they would remove all CPEs.
+func ReadFilters(reader io.Reader) ([]FilterFunc, error) {
+	var rules []FilterRule
+	if err := yaml.NewDecoder(reader).Decode(&rules); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to parse CPE filter rules: %w", err)
+	}
+
+	var filters []FilterFunc
+	for i, rule := range rules {
+		fn, err := rule.filter()
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPE filter rule %d: %w", i+1, err)
+		}
+		filters = append(filters, fn)
+	}
+	return filters, nil
+}
+
+func (r FilterRule) filter() (FilterFunc, error) {
+	if r.Product == "" && r.Vendor == "" && r.PackageNameContains == "" {
+		return nil, fmt.Errorf("no conditions given")
+	}
+
+	return func(cpe pkg.CPE, p pkg.Package, _ *linux.Release) bool {
+		if r.Product != "" && !strings.EqualFold(cpe.Product, r.Product) {
+			return false
+		}
+		if r.Vendor != "" && !strings.EqualFold(cpe.Vendor, r.Vendor) {
+			return false
+		}
+		if r.PackageNameContains != "" && !strings.Contains(strings.ToLower(p.Name), strings.ToLower(r.PackageNameContains)) {
+			return false
+		}
+		return true
+	}, nil
+}
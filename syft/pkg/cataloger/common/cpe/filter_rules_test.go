@@ -0,0 +1,90 @@
+package cpe
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFilters(t *testing.T) {
+	acmeWidgets := pkg.Package{Name: "acme-widgets"}
+	otherWidgets := pkg.Package{Name: "widgets"}
+
+	tests := []struct {
+		name    string
+		input   string
+		removed map[string]pkg.Package // CPEs that are expected to be removed for the given package
+		kept    map[string]pkg.Package // CPEs that are expected to be kept for the given package
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "yaml",
+			input: `
+- product: widgets
+  package-name-contains: acme-
+`,
+			removed: map[string]pkg.Package{
+				"cpe:2.3:a:acme:widgets:1.0:*:*:*:*:*:*:*": acmeWidgets,
+				"cpe:2.3:a:acme:WIDGETS:1.0:*:*:*:*:*:*:*": acmeWidgets,
+			},
+			kept: map[string]pkg.Package{
+				"cpe:2.3:a:acme:widgets:1.0:*:*:*:*:*:*:*":      otherWidgets,
+				"cpe:2.3:a:acme:acme-widgets:1.0:*:*:*:*:*:*:*": acmeWidgets,
+			},
+		},
+		{
+			name:  "json",
+			input: `[{"vendor": "acme"}]`,
+			removed: map[string]pkg.Package{
+				"cpe:2.3:a:acme:widgets:1.0:*:*:*:*:*:*:*": otherWidgets,
+			},
+			kept: map[string]pkg.Package{
+				"cpe:2.3:a:widgets:widgets:1.0:*:*:*:*:*:*:*": otherWidgets,
+			},
+		},
+		{
+			name:  "empty",
+			input: "",
+		},
+		{
+			name:    "rule without conditions",
+			input:   `- product: ""`,
+			wantErr: require.Error,
+		},
+		{
+			name:    "bad structure",
+			input:   `product: widgets`,
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			filters, err := ReadFilters(strings.NewReader(test.input))
+			test.wantErr(t, err)
+
+			removed := func(cpe string, p pkg.Package) bool {
+				for _, fn := range filters {
+					if fn(pkg.MustCPE(cpe), p, nil) {
+						return true
+					}
+				}
+				return false
+			}
+			for cpe, p := range test.removed {
+				if !removed(cpe, p) {
+					t.Errorf("expected %s to be removed for %q", cpe, p.Name)
+				}
+			}
+			for cpe, p := range test.kept {
+				if removed(cpe, p) {
+					t.Errorf("expected %s to be kept for %q", cpe, p.Name)
+				}
+			}
+		})
+	}
+}
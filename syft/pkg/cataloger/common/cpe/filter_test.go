@@ -1,8 +1,10 @@
 package cpe
 
 import (
+	"regexp"
 	"testing"
 
+	"github.com/anchore/syft/syft/linux"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/stretchr/testify/assert"
 )
@@ -41,7 +43,7 @@ func Test_disallowJenkinsServerCPEForPluginPackage(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.Equal(t, test.expected, disallowJenkinsServerCPEForPluginPackage(test.cpe, test.pkg))
+			assert.Equal(t, test.expected, disallowJenkinsServerCPEForPluginPackage(test.cpe, test.pkg, nil))
 		})
 	}
 }
@@ -101,7 +103,7 @@ func Test_disallowJenkinsCPEsNotAssociatedWithJenkins(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.Equal(t, test.expected, disallowJenkinsCPEsNotAssociatedWithJenkins(test.cpe, test.pkg))
+			assert.Equal(t, test.expected, disallowJenkinsCPEsNotAssociatedWithJenkins(test.cpe, test.pkg, nil))
 		})
 	}
 }
@@ -161,7 +163,254 @@ func Test_disallowJiraClientServerMismatch(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.Equal(t, test.expected, disallowJiraClientServerMismatch(test.cpe, test.pkg))
+			assert.Equal(t, test.expected, disallowJiraClientServerMismatch(test.cpe, test.pkg, nil))
 		})
 	}
 }
+
+func Test_disallowLog4jAPIMatchingLog4jCore(t *testing.T) {
+	log4jAPI := pkg.Package{
+		Name:         "log4j-api",
+		Version:      "2.14.1",
+		Language:     pkg.Java,
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			PomProperties: &pkg.PomProperties{
+				GroupID:    "org.apache.logging.log4j",
+				ArtifactID: "log4j-api",
+				Version:    "2.14.1",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		cpe      pkg.CPE
+		pkg      pkg.Package
+		expected bool
+	}{
+		{
+			name:     "filter out log4j product (apache vendor)",
+			cpe:      pkg.MustCPE("cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"),
+			pkg:      log4jAPI,
+			expected: true,
+		},
+		{
+			name:     "filter out log4j product (log4j vendor)",
+			cpe:      pkg.MustCPE("cpe:2.3:a:log4j:log4j:2.14.1:*:*:*:*:*:*:*"),
+			pkg:      log4jAPI,
+			expected: true,
+		},
+		{
+			name:     "ignore CPEs that indicate the api artifact",
+			cpe:      pkg.MustCPE("cpe:2.3:a:log4j-api:log4j:2.14.1:*:*:*:*:*:*:*"),
+			pkg:      log4jAPI,
+			expected: false,
+		},
+		{
+			name:     "ignore the api product",
+			cpe:      pkg.MustCPE("cpe:2.3:a:apache:log4j-api:2.14.1:*:*:*:*:*:*:*"),
+			pkg:      log4jAPI,
+			expected: false,
+		},
+		{
+			name: "ignore log4j-core",
+			cpe:  pkg.MustCPE("cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"),
+			pkg: pkg.Package{
+				Name:     "log4j-core",
+				Language: pkg.Java,
+				Type:     pkg.JavaPkg,
+			},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, disallowLog4jAPIMatchingLog4jCore(test.cpe, test.pkg, nil))
+		})
+	}
+}
+
+func Test_isPrivatePackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		pkg      pkg.Package
+		expected bool
+	}{
+		{
+			name: "no private namespaces configured",
+			pkg: pkg.Package{
+				Name: "@internal/widget",
+				Type: pkg.NpmPkg,
+			},
+			expected: false,
+		},
+		{
+			name: "scoped package with private prefix",
+			cfg:  Config{PrivateNamespaces: []string{"@internal/"}},
+			pkg: pkg.Package{
+				Name: "@internal/widget",
+				Type: pkg.NpmPkg,
+			},
+			expected: true,
+		},
+		{
+			name: "public scoped package",
+			cfg:  Config{PrivateNamespaces: []string{"@internal/"}},
+			pkg: pkg.Package{
+				Name: "@angular/core",
+				Type: pkg.NpmPkg,
+			},
+			expected: false,
+		},
+		{
+			name: "java package with private group ID",
+			cfg:  Config{PrivateNamespaces: []string{"com.mycompany."}},
+			pkg: pkg.Package{
+				Name:         "widget",
+				Type:         pkg.JavaPkg,
+				MetadataType: pkg.JavaMetadataType,
+				Metadata: pkg.JavaMetadata{
+					PomProperties: &pkg.PomProperties{
+						GroupID:    "com.mycompany.platform",
+						ArtifactID: "widget",
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "package matching private pattern",
+			cfg:  Config{PrivateNamespacePatterns: []*regexp.Regexp{regexp.MustCompile(`^acme-.*-internal$`)}},
+			pkg: pkg.Package{
+				Name: "acme-billing-internal",
+				Type: pkg.PythonPkg,
+			},
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isPrivatePackage(test.pkg, test.cfg))
+		})
+	}
+}
+
+func Test_filter_distroAware(t *testing.T) {
+	// a filter for a product name that only collides with another project on alpine
+	disallowAlpineCollision := func(cpe pkg.CPE, _ pkg.Package, d *linux.Release) bool {
+		return d != nil && d.ID == "alpine" && cpe.Product == "collision"
+	}
+
+	cpes := []pkg.CPE{
+		pkg.MustCPE("cpe:2.3:a:collision:collision:1.0:*:*:*:*:*:*:*"),
+		pkg.MustCPE("cpe:2.3:a:other:other:1.0:*:*:*:*:*:*:*"),
+	}
+	p := pkg.Package{
+		Name:    "collision",
+		Version: "1.0",
+		Type:    pkg.ApkPkg,
+	}
+
+	tests := []struct {
+		name     string
+		release  *linux.Release
+		expected []pkg.CPE
+	}{
+		{
+			name:     "alpine",
+			release:  &linux.Release{ID: "alpine"},
+			expected: cpes[1:],
+		},
+		{
+			name:     "other distro",
+			release:  &linux.Release{ID: "debian"},
+			expected: cpes,
+		},
+		{
+			name:     "unknown distro",
+			release:  nil,
+			expected: cpes,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, filter(cpes, p, test.release, disallowAlpineCollision))
+		})
+	}
+}
+
+func Test_exemptProducts(t *testing.T) {
+	cpes := []pkg.CPE{
+		pkg.MustCPE("cpe:2.3:a:atlassian:jira:1.0:*:*:*:*:*:*:*"),
+		pkg.MustCPE("cpe:2.3:a:atlassian:jira_client:1.0:*:*:*:*:*:*:*"),
+	}
+	p := pkg.Package{
+		Name: "jira-client",
+		Type: pkg.JavaPkg,
+	}
+
+	assert.Equal(t, cpes[1:], filter(cpes, p, nil, disallowJiraClientServerMismatch))
+	assert.Equal(t, cpes, filter(cpes, p, nil, exemptProducts([]string{"jira"}, disallowJiraClientServerMismatch)...))
+	assert.Equal(t, cpes[1:], filter(cpes, p, nil, exemptProducts(nil, disallowJiraClientServerMismatch)...))
+}
+
+func TestGenerateWithConfig_PrivateNamespaces(t *testing.T) {
+	p := pkg.Package{
+		Name:     "@internal/widget",
+		Version:  "1.2.3",
+		Language: pkg.JavaScript,
+		Type:     pkg.NpmPkg,
+	}
+
+	assert.NotEmpty(t, GenerateWithConfig(p, Config{}))
+	assert.Empty(t, GenerateWithConfig(p, Config{PrivateNamespaces: []string{"@internal/"}}))
+}
+
+func TestGenerateWithConfig_KeepCuratedProducts(t *testing.T) {
+	p := pkg.Package{
+		Name:    "jira-rest-java-client",
+		Version: "5.2.4",
+		Type:    pkg.JavaPkg,
+	}
+
+	// the package is a jira client, which the filters do not allow to match the jira product
+	cfg := Config{
+		ProductCandidates: map[pkg.Type]map[string][]string{
+			pkg.JavaPkg: {"jira-rest-java-client": {"jira"}},
+		},
+	}
+	expected := "cpe:2.3:a:jira:jira:5.2.4:*:*:*:*:*:*:*"
+
+	assert.NotContains(t, cpeStrings(GenerateWithConfig(p, cfg)), expected)
+
+	cfg.KeepCuratedProducts = true
+	assert.Contains(t, cpeStrings(GenerateWithConfig(p, cfg)), expected)
+}
+
+func TestGenerateWithConfig_Filters(t *testing.T) {
+	p := pkg.Package{
+		Name:     "jira-client",
+		Version:  "1.0",
+		Language: pkg.Java,
+		Type:     pkg.JavaPkg,
+	}
+
+	cfg := DefaultConfig()
+	cfg.Filters = []FilterFunc{
+		func(cpe pkg.CPE, _ pkg.Package, _ *linux.Release) bool {
+			return cpe.Vendor == "jira-client"
+		},
+	}
+
+	actual := cpeStrings(GenerateWithConfig(p, cfg))
+	assert.NotEmpty(t, actual)
+	for _, c := range actual {
+		assert.NotContains(t, c, ":jira-client:jira-client:", "the configured filter must be applied")
+		// the built-in filters must remain active
+		assert.NotContains(t, c, ":jira:1.0:", "the jira client must not be described as jira")
+	}
+	assert.Contains(t, actual, "cpe:2.3:a:jira_client:jira-client:1.0:*:*:*:*:*:*:*")
+}
@@ -3,21 +3,21 @@ package cpe
 import (
 	"bufio"
 	"bytes"
-	"fmt"
 	"sort"
 	"strings"
 
-	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/linux"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/facebookincubator/nvdtools/wfn"
 )
 
-func newCPE(product, vendor, version, targetSW string) *wfn.Attributes {
+func newCPE(product, vendor, version, update, targetSW string) *wfn.Attributes {
 	cpe := *(wfn.NewAttributesWithAny())
 	cpe.Part = "a"
 	cpe.Product = product
 	cpe.Vendor = vendor
 	cpe.Version = version
+	cpe.Update = update
 	cpe.TargetSW = targetSW
 	if pkg.ValidateCPEString(pkg.CPEString(cpe)) != nil {
 		return nil
@@ -28,45 +28,199 @@ func newCPE(product, vendor, version, targetSW string) *wfn.Attributes {
 // Generate Create a list of CPEs for a given package, trying to guess the vendor, product tuple. We should be trying to
 // generate the minimal set of representative CPEs, which implies that optional fields should not be included
 // (such as target SW).
+//
+// Only the identifying fields of the package are used (name, version, type, language, PURL, and metadata), so the
+// package may be constructed by the caller rather than found by a cataloger. The given package is never modified and no
+// state is shared between calls, so it is safe to call Generate concurrently. The returned CPEs are sorted from most to
+// least specific, and the same package always results in the same CPEs in the same order.
 func Generate(p pkg.Package) []pkg.CPE {
-	vendors := candidateVendors(p)
-	products := candidateProducts(p)
+	return GenerateWithConfig(p, DefaultConfig())
+}
+
+// GenerateWithConfig is the same as Generate, however, the candidate generation is tuned with the given Config. The same
+// guarantees apply, as long as any Config.PostProcess function is itself safe to call concurrently.
+func GenerateWithConfig(p pkg.Package, cfg Config) []pkg.CPE {
+	return GenerateWithRelease(p, nil, cfg)
+}
+
+// GenerateWithRelease is the same as GenerateWithConfig, however, the distro the package was found on is considered when
+// filtering out CPEs that are known to be false positives. A nil release is treated as an unknown distro.
+func GenerateWithRelease(p pkg.Package, release *linux.Release, cfg Config) []pkg.CPE {
+	// metapackages have no code of their own, so there is nothing that could be vulnerable
+	if isMetapackage(p) {
+		return nil
+	}
+
+	p = relocateJavaPackage(p)
+	if p.Type == pkg.NpmPkg {
+		p = stripDistTag(p)
+	}
+
+	// packages that are never published have no CPEs that could be meaningfully matched
+	if isPrivatePackage(p, cfg) {
+		return nil
+	}
+
+	vendors := candidateVendors(p, cfg)
+	products := candidateProducts(p, cfg)
+
+	if cfg.IncludePURLCandidates {
+		purlVendors, purlProducts := candidatesFromPURL(p.PURL)
+		vendors = appendMissing(vendors, purlVendors...)
+		products = appendMissing(products, purlProducts...)
+	}
+
 	if len(products) == 0 {
 		return nil
 	}
 
-	keys := internal.NewStringSet()
-	cpes := make([]pkg.CPE, 0)
+	if cfg.IncludeRoleProducts {
+		if roles := roleProducts(p.Name); len(roles) > 0 {
+			// the project is the owner of all of the role-specific products (e.g. postgresql -> postgresql_server)
+			vendors = append(vendors, roles[0])
+			products = append(products, roles...)
+		}
+	}
+
+	if cfg.StripLocaleSuffixes {
+		if product := stripLocaleSuffix(p.Name); product != "" {
+			products = append(products, product)
+		}
+	}
+
+	if cfg.IncludeVendorProductConcatenations {
+		products = addVendorProductConcatenations(vendors, products)
+	}
+
+	if cfg.IncludeASCIIFolded {
+		vendors = addASCIIFoldedVariations(vendors)
+		products = addASCIIFoldedVariations(products)
+	}
+
+	var candidates []vendorProduct
 	for _, product := range products {
 		for _, vendor := range vendors {
-			// prevent duplicate entries...
-			key := fmt.Sprintf("%s|%s|%s", product, vendor, p.Version)
-			if keys.Contains(key) {
-				continue
+			candidates = append(candidates, vendorProduct{vendor: vendor, product: product})
+		}
+	}
+
+	// curated pairs for well-known software are used as-is
+	candidates = append(candidates, knownSoftwareVendorProducts(p)...)
+
+	targetSWs := []string{wfn.Any}
+	if cfg.IncludeTargetSoftware {
+		targetSWs = append(targetSWs, candidateTargetSoftwareAttrs(p)...)
+	}
+
+	languages := []string{wfn.Any}
+	if cfg.IncludeLanguage {
+		if language := localeLanguage(p.Name); language != "" {
+			languages = append(languages, language)
+		}
+	}
+
+	// the index of each CPE by its case-insensitive binding, used for deduplication
+	keys := make(map[string]int)
+	cpes := make([]pkg.CPE, 0)
+	for _, candidateVersion := range candidateVersions(p, cfg) {
+		version, update := candidateVersion, wfn.Any
+		if cfg.VersionUpdateFromSuffix {
+			version, update = splitVersionUpdate(candidateVersion)
+		}
+
+		if !cfg.PreserveCase {
+			version, update = strings.ToLower(version), strings.ToLower(update)
+		}
+
+		for _, candidate := range candidates {
+			if !cfg.PreserveCase {
+				candidate.vendor, candidate.product = strings.ToLower(candidate.vendor), strings.ToLower(candidate.product)
 			}
-			keys.Add(key)
-			// add a new entry...
-			if cpe := newCPE(product, vendor, p.Version, wfn.Any); cpe != nil {
-				cpes = append(cpes, *cpe)
+
+			for _, targetSW := range targetSWs {
+				for _, language := range languages {
+					cpe := newCPE(candidate.product, candidate.vendor, version, update, targetSW)
+					if cpe == nil {
+						continue
+					}
+					cpe.Language = language
+
+					// prevent duplicate entries... CPE matching is case-insensitive, so candidates that only differ by
+					// case (which is possible when preserving case) describe the same CPE. Since candidates are not
+					// ordered, the lowest binding is kept to stay deterministic.
+					binding := pkg.CPEString(*cpe)
+					key := strings.ToLower(binding)
+					if i, ok := keys[key]; ok {
+						if binding < pkg.CPEString(cpes[i]) {
+							cpes[i] = *cpe
+						}
+						continue
+					}
+					keys[key] = len(cpes)
+					cpes = append(cpes, *cpe)
+				}
 			}
 		}
 	}
 
+	if cfg.IncludeGoToolchain {
+		if cpe := goToolchainCPE(p); cpe != nil {
+			cpes = append(cpes, *cpe)
+		}
+	}
+
 	// filter out any known combinations that don't accurately represent this package
-	cpes = filter(cpes, p, cpeFilters...)
+	builtinFilters := cpeFilters
+	if cfg.KeepCuratedProducts {
+		builtinFilters = exemptProducts(curatedProducts(p, cfg), builtinFilters...)
+	}
+	filters := append(append([]FilterFunc{}, builtinFilters...), cfg.Filters...)
+	candidateCPEs := len(cpes)
+	cpes = filter(cpes, p, release, filters...)
+
+	if cfg.Observer != nil {
+		cfg.Observer(p, Metrics{
+			Vendors:        len(vendors),
+			Products:       len(products),
+			TargetSoftware: len(targetSWs),
+			CandidateCPEs:  candidateCPEs,
+			CPEs:           len(cpes),
+		})
+	}
+
+	if cfg.PostProcess != nil {
+		cpes = cfg.PostProcess(cpes, p)
+	}
 
 	sort.Sort(pkg.CPEBySpecificity(cpes))
 
 	return cpes
 }
 
-func candidateVendors(p pkg.Package) []string {
+// organizationPrefixes are name prefixes that indicate the organization that owns the project (the vendor), which NVD
+// does not include in the product name (e.g. apache-tomcat -> apache:tomcat)
+var organizationPrefixes = map[string]string{
+	"apache-": "apache",
+}
+
+// distributionSuffixes are name suffixes that indicate the form a project was distributed in (binaries, a packaged
+// distribution, or sources), not the project itself (e.g. kafka-src -> kafka)
+var distributionSuffixes = []string{"-bin", "-dist", "-src"}
+
+// buildSystemPrefixes are name prefixes that indicate how a C/C++ project was packaged, not the project itself
+var buildSystemPrefixes = []string{"cmake-", "autotools-", "meson-"}
+
+func candidateVendors(p pkg.Package, cfg Config) []string {
 	// in ecosystems where the packaging metadata does not have a clear field to indicate a vendor (or a field that
 	// could be interpreted indirectly as such) the project name tends to be a common stand in. Examples of this
 	// are the elasticsearch gem, xstream jar, and rack gem... all of these cases you can find vulnerabilities
 	// with CPEs where the vendor is the product name and doesn't appear to be derived from any available package
 	// metadata.
-	vendors := newFieldCandidateSet(candidateProducts(p)...)
+	products := candidateProducts(p, cfg)
+	vendors := newFieldCandidateSet()
+	if !cfg.ExcludeProductVendors {
+		vendors.addValue(products...)
+	}
 
 	switch p.Language {
 	case pkg.Ruby:
@@ -75,10 +229,37 @@ func candidateVendors(p pkg.Package) []string {
 		// replace all candidates with only the golang-specific helper
 		vendors.clear()
 
+		if isGoStdlib(p.Name) {
+			// the toolchain is listed under both vendors (e.g. golang:go and go:go)
+			vendors.addValue("golang", "go")
+			break
+		}
+
 		vendor := candidateVendorForGo(p.Name)
 		if vendor != "" {
 			vendors.addValue(vendor)
 		}
+	case pkg.Swift:
+		// the owner of the repository a swift package is named after is the vendor (e.g. github.com/Alamofire/...)
+		if vendor := candidateVendorForSwift(p.Name); vendor != "" {
+			vendors.addValue(vendor)
+		}
+	}
+
+	if vendor, _ := splitOrganizationPrefix(p.Name); vendor != "" {
+		vendors.addValue(vendor)
+	}
+
+	if vendor, _ := splitComposerName(p); vendor != "" {
+		vendors.addValue(vendor)
+	}
+
+	// the first segment of a NuGet package name is the owning organization (e.g. Newtonsoft.Json -> newtonsoft)
+	vendors.addValue(candidateVendorsForDotnet(p)...)
+
+	// the scope of an npm package is typically the organization or project that publishes it (e.g. @babel/parser -> babel)
+	if scope, _ := splitNpmScope(p); scope != "" {
+		vendors.addValue(scope)
 	}
 
 	// some ecosystems do not have enough metadata to determine the vendor accurately, in which case we selectively
@@ -100,36 +281,137 @@ func candidateVendors(p pkg.Package) []string {
 	}
 
 	// try swapping hyphens for underscores, vice versa, and removing separators altogether
-	addDelimiterVariations(vendors)
+	if !cfg.ExcludeDelimiterVariations {
+		addDelimiterVariations(vendors)
+	}
 
 	// generate sub-selections of each candidate based on separators (e.g. jenkins-ci -> [jenkins, jenkins-ci])
-	addAllSubSelections(vendors)
+	if !cfg.ExcludeSubSelections {
+		addAllSubSelections(vendors, cfg.maxSubSelections())
+	}
 
 	// add more candidates based on the package info for each vendor candidate
+	additions := cfg.candidateAdditions()
 	for _, vendor := range vendors.uniqueValues() {
-		vendors.addValue(findAdditionalVendors(defaultCandidateAdditions, p.Type, p.Name, vendor)...)
+		vendors.addValue(findAdditionalVendors(additions, p.Type, p.Name, vendor)...)
+	}
+
+	// add the vendors known to own any of the product candidates (e.g. jetty-server -> jetty -> eclipse)
+	var productSelections []string
+	for _, product := range products {
+		productSelections = append(productSelections, generateSubSelections(product)...)
+	}
+	vendors.addValue(findVendorAliases(p.Type, cfg.VendorAliases, productSelections...)...)
+
+	if len(cfg.VendorSeparatorPreference) > 0 {
+		return keepCanonicalSeparatorVariants(vendors.uniqueValues(), cfg.VendorSeparatorPreference)
 	}
 
 	return vendors.uniqueValues()
 }
 
-func candidateProducts(p pkg.Package) []string {
+func candidateProducts(p pkg.Package, cfg Config) []string {
 	products := newFieldCandidateSet(p.Name)
 
+	// repeated, leading, or trailing separators are never part of a product name in NVD (e.g. foo--bar -> foo-bar)
+	products.addValue(collapseSeparators(p.Name))
+
+	// some packages are named after their homepage domain (e.g. curl.se -> curl)
+	products.addValue(candidateProductsFromDomain(p)...)
+
+	// the owning organization is not part of the product name (e.g. apache-tomcat -> tomcat)
+	if _, product := splitOrganizationPrefix(p.Name); product != "" {
+		products.addValue(product)
+	}
+
+	// the form the project was distributed in is not part of the product name (e.g. kafka-src -> kafka)
+	if product := trimDistributionSuffix(p.Name); product != "" {
+		products.addValue(product)
+	}
+
+	// display names of installed programs contain spaces, which are never part of a product name in NVD
+	products.addValue(candidateProductsForDisplayName(p.Name)...)
+
 	switch {
 	case p.Language == pkg.Python:
 		if !strings.HasPrefix(p.Name, "python") {
 			products.addValue("python-" + p.Name)
 		}
+		// dotted names are listed in NVD both as-is and hyphenated (e.g. ruamel.yaml -> ruamel-yaml)
+		if strings.Contains(p.Name, ".") {
+			products.addValue(strings.ReplaceAll(p.Name, ".", "-"))
+		}
+		// PyPI considers names that differ only by case and separators to be the same project (PEP 503)
+		products.addValue(normalizePythonName(p.Name))
+		products.addValue(candidateProductsForPython(p)...)
 	case p.Language == pkg.Java || p.MetadataType == pkg.JavaMetadataType:
 		products.addValue(candidateProductsForJava(p)...)
 	case p.Language == pkg.Go:
 		// replace all candidates with only the golang-specific helper
 		products.clear()
 
+		if isGoStdlib(p.Name) {
+			products.addValue("go")
+			break
+		}
+
 		prod := candidateProductForGo(p.Name)
 		if prod != "" {
 			products.addValue(prod)
+			// go ports of a project tend to be listed under the project name (e.g. github.com/grpc/grpc-go -> grpc)
+			if trimmed := trimGoPortSuffix(prod); trimmed != prod {
+				products.addValue(trimmed)
+			}
+			// gopkg.in modules are versioned in the path, which NVD does not include (e.g. gopkg.in/yaml.v2 -> yaml)
+			if trimmed := trimGoPkgInVersionSuffix(p.Name, prod); trimmed != prod {
+				products.addValue(trimmed)
+			}
+		}
+	case p.Language == pkg.Swift:
+		// swift packages named after their repository URL are described by the repository name only
+		if prod := candidateProductForSwift(p.Name); prod != "" {
+			products.clear()
+			products.addValue(prod)
+		}
+	case p.Language == pkg.CPP || p.Type == pkg.ConanPkg:
+		// some recipes are named after the build system used to package the project (e.g. cmake-fmt -> fmt)
+		name := p.Name
+		for _, prefix := range buildSystemPrefixes {
+			if strings.HasPrefix(p.Name, prefix) {
+				name = strings.TrimPrefix(p.Name, prefix)
+				products.addValue(name)
+			}
+		}
+		products.addValue(candidateProductsForCLibrary(name)...)
+	case p.MetadataType == pkg.ApkMetadataType:
+		products.addValue(candidateProductsForAPK(p)...)
+		products.addValue(candidateProductsForLibrary(p.Name)...)
+	case p.Type == pkg.DebPkg || p.Type == pkg.RpmPkg || p.Type == pkg.AlpmPkg || p.Type == pkg.PortagePkg:
+		products.addValue(candidateProductsForLibrary(p.Name)...)
+	case p.Type == pkg.DotnetPkg:
+		// runtime specific assets are published as separate packages (e.g. runtime.linux-x64.Microsoft.NETCore.App)
+		if product := trimDotnetRuntimePrefix(p.Name); product != "" {
+			products.addValue(product)
+		}
+		// NuGet names are dot separated and prefixed with the owning organization (e.g. Newtonsoft.Json -> newtonsoft_json)
+		products.addValue(candidateProductsForDotnet(p)...)
+	case p.Type == pkg.PhpComposerPkg:
+		if _, product := splitComposerName(p); product != "" {
+			// the vendor is not part of the product name (e.g. symfony/console -> console)
+			products.clear()
+			products.addValue(product)
+		}
+	case p.Type == pkg.NpmPkg:
+		name := p.Name
+		if scoped := candidateProductsForNpm(p); scoped != nil {
+			// "@" and "/" are never part of a product name, so the scoped name is replaced (e.g. @angular/core -> core)
+			products.clear()
+			products.addValue(scoped...)
+			name = scoped[0]
+		}
+		// node bindings and ports are commonly listed without the "node-" prefix (e.g. node-fetch -> fetch)
+		if strings.HasPrefix(name, "node-") {
+			products.addValue(strings.TrimPrefix(name, "node-"))
 		}
 	}
 	// it is never OK to have candidates with these values ["" and "*"] (since CPEs will match any other value)
@@ -137,20 +419,30 @@ func candidateProducts(p pkg.Package) []string {
 	products.removeByValue("*")
 
 	// try swapping hyphens for underscores, vice versa, and removing separators altogether
-	addDelimiterVariations(products)
+	if !cfg.ExcludeDelimiterVariations {
+		addDelimiterVariations(products)
+	}
 
 	// add known candidate additions
-	products.addValue(findAdditionalProducts(defaultCandidateAdditions, p.Type, p.Name)...)
+	products.addValue(findAdditionalProducts(cfg.candidateAdditions(), p.Type, p.Name)...)
 
 	return products.uniqueValues()
 }
 
-func addAllSubSelections(fields fieldCandidateSet) {
+// addAllSubSelections adds the sub-selections of every candidate that allows for them. Candidates with more than
+// maxSubSelections sub-selections only keep the first (the first token) and the last (the full field). A
+// maxSubSelections of zero or less means there is no limit.
+func addAllSubSelections(fields fieldCandidateSet, maxSubSelections int) {
 	candidatesForVariations := fields.copy()
 	candidatesForVariations.removeWhere(subSelectionsDisallowed)
 
 	for _, candidate := range candidatesForVariations.values() {
-		fields.addValue(generateSubSelections(candidate)...)
+		subSelections := generateSubSelections(candidate)
+		if maxSubSelections > 0 && len(subSelections) > maxSubSelections {
+			// names with this many separators are rarely meaningful to split, so only keep the most likely candidates
+			subSelections = []string{subSelections[0], subSelections[len(subSelections)-1]}
+		}
+		fields.addValue(subSelections...)
 	}
 }
 
@@ -237,3 +529,63 @@ func addDelimiterVariations(fields fieldCandidateSet) {
 		}
 	}
 }
+
+// splitOrganizationPrefix returns the vendor for the organization prefix of the given name and the remaining product
+// name (e.g. apache-tomcat -> apache, tomcat). Empty strings are returned if the name has no organization prefix.
+func splitOrganizationPrefix(name string) (string, string) {
+	for prefix, vendor := range organizationPrefixes {
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			return vendor, strings.TrimPrefix(name, prefix)
+		}
+	}
+	return "", ""
+}
+
+// trimDistributionSuffix returns the given name without any distribution suffix. An empty string is returned if the name
+// does not have a distribution suffix.
+func trimDistributionSuffix(name string) string {
+	for _, suffix := range distributionSuffixes {
+		if strings.HasSuffix(name, suffix) && len(name) > len(suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+	return ""
+}
+
+// keepCanonicalSeparatorVariants collapses all values that differ only by hyphens and underscores into a single value,
+// choosing the variant whose separator appears first in the given preference order (with the longer value winning ties).
+func keepCanonicalSeparatorVariants(values []string, preference []string) (results []string) {
+	rank := func(value string) int {
+		hasHyphen := strings.Contains(value, "-")
+		hasUnderscore := strings.Contains(value, "_")
+		for i, sep := range preference {
+			switch {
+			case sep == "-" && hasHyphen && !hasUnderscore,
+				sep == "_" && hasUnderscore && !hasHyphen,
+				sep == "" && !hasHyphen && !hasUnderscore:
+				return i
+			}
+		}
+		return len(preference)
+	}
+
+	var keys []string
+	canonical := make(map[string]string)
+	for _, value := range values {
+		key := strings.NewReplacer("-", "", "_", "").Replace(value)
+		existing, ok := canonical[key]
+		if !ok {
+			keys = append(keys, key)
+			canonical[key] = value
+			continue
+		}
+		if r, er := rank(value), rank(existing); r < er || (r == er && len(value) > len(existing)) {
+			canonical[key] = value
+		}
+	}
+
+	for _, key := range keys {
+		results = append(results, canonical[key])
+	}
+	return results
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/anchore/syft/syft/pkg"
@@ -100,6 +101,43 @@ func TestGeneratePackageCPEs(t *testing.T) {
 				"cpe:2.3:a:william_goodman:python_name:3.2:*:*:*:*:*:*:*",
 			},
 		},
+		{
+			name: "python package from a poetry.lock git source",
+			p: pkg.Package{
+				Name:         "pyjwt",
+				Version:      "2.4.0",
+				FoundBy:      "some-analyzer",
+				Language:     pkg.Python,
+				Type:         pkg.PythonPkg,
+				MetadataType: pkg.PythonPackageMetadataType,
+				Metadata: pkg.PythonPackageMetadata{
+					Name:    "pyjwt",
+					Version: "2.4.0",
+					DirectURLOrigin: &pkg.PythonDirectURLOriginInfo{
+						URL:      "https://github.com/jpadilla/pyjwt.git",
+						CommitID: "4f8e2a8d6e0d5f9a7c1c1e3f5e6b2b1a0d9c8e7f",
+						VCS:      "git",
+					},
+				},
+			},
+			expected: []string{
+				"cpe:2.3:a:pyjwt:pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:pyjwt:python-pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:pyjwt:python_pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python-pyjwt:pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python-pyjwt:python-pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python-pyjwt:python_pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python_pyjwt:pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python_pyjwt:python-pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python_pyjwt:python_pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python:pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python:python-pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:python:python_pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:jpadilla:pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:jpadilla:python-pyjwt:2.4.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:jpadilla:python_pyjwt:2.4.0:*:*:*:*:*:*:*",
+			},
+		},
 		{
 			name: "javascript language",
 			p: pkg.Package{
@@ -343,6 +381,9 @@ func TestGeneratePackageCPEs(t *testing.T) {
 				"cpe:2.3:a:name:name:1\\:3.2:*:*:*:*:*:*:*",
 				"cpe:2.3:a:some-vendor:name:1\\:3.2:*:*:*:*:*:*:*",
 				"cpe:2.3:a:some_vendor:name:1\\:3.2:*:*:*:*:*:*:*",
+				"cpe:2.3:a:name:name:3.2:*:*:*:*:*:*:*",
+				"cpe:2.3:a:some-vendor:name:3.2:*:*:*:*:*:*:*",
+				"cpe:2.3:a:some_vendor:name:3.2:*:*:*:*:*:*:*",
 			},
 		},
 		{
@@ -357,6 +398,7 @@ func TestGeneratePackageCPEs(t *testing.T) {
 			},
 			expected: []string{
 				"cpe:2.3:a:name:name:1\\:3.2:*:*:*:*:*:*:*",
+				"cpe:2.3:a:name:name:3.2:*:*:*:*:*:*:*",
 			},
 		},
 		{
@@ -488,6 +530,16 @@ func TestGeneratePackageCPEs(t *testing.T) {
 				"cpe:2.3:a:jira_client_core:jira_client_core:3.2:*:*:*:*:*:*:*",
 			},
 		},
+		{
+			name: "malformed version",
+			p: pkg.Package{
+				Name:    "widget",
+				Version: "1.2.3.RELEASE",
+			},
+			expected: []string{
+				"cpe:2.3:a:widget:widget:1.2.3:*:*:*:*:*:*:*",
+			},
+		},
 		{
 			name: "jenkins filtering",
 			p: pkg.Package{
@@ -665,6 +717,72 @@ func TestGeneratePackageCPEs(t *testing.T) {
 				"cpe:2.3:a:stephanie_morillo:bundler:2.1.4:*:*:*:*:*:*:*",
 			},
 		},
+		{
+			name: "curated binary name",
+			p: pkg.Package{
+				Name:    "apache2",
+				Version: "2.4.54",
+				Type:    pkg.DebPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:apache2:apache2:2.4.54:*:*:*:*:*:*:*",
+				"cpe:2.3:a:apache:http_server:2.4.54:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "curated binary name with separators",
+			p: pkg.Package{
+				Name:    "redis-server",
+				Version: "6.0.16",
+				Type:    pkg.DebPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:redis-server:redis-server:6.0.16:*:*:*:*:*:*:*",
+				"cpe:2.3:a:redis-server:redis_server:6.0.16:*:*:*:*:*:*:*",
+				"cpe:2.3:a:redis:redis-server:6.0.16:*:*:*:*:*:*:*",
+				"cpe:2.3:a:redis:redis:6.0.16:*:*:*:*:*:*:*",
+				"cpe:2.3:a:redis:redis_server:6.0.16:*:*:*:*:*:*:*",
+				"cpe:2.3:a:redis_server:redis-server:6.0.16:*:*:*:*:*:*:*",
+				"cpe:2.3:a:redis_server:redis_server:6.0.16:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "curated servlet container binary name (tomcat)",
+			p: pkg.Package{
+				Name:    "tomcat9",
+				Version: "9.0.43",
+				Type:    pkg.DebPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:tomcat9:tomcat9:9.0.43:*:*:*:*:*:*:*",
+				"cpe:2.3:a:apache:tomcat:9.0.43:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "curated servlet container binary name (jetty)",
+			p: pkg.Package{
+				Name:    "jetty9",
+				Version: "9.4.39",
+				Type:    pkg.DebPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:jetty9:jetty9:9.4.39:*:*:*:*:*:*:*",
+				"cpe:2.3:a:eclipse:jetty:9.4.39:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "curated binary names are not considered for language packages",
+			p: pkg.Package{
+				Name:     "node",
+				Version:  "18.7.0",
+				Type:     pkg.NpmPkg,
+				Language: pkg.JavaScript,
+			},
+			expected: []string{
+				"cpe:2.3:a:*:node:18.7.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:node:node:18.7.0:*:*:*:*:*:*:*",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -770,6 +888,145 @@ func TestCandidateProducts(t *testing.T) {
 			},
 			expected: []string{"handlebars" /* <-- known good names | default guess --> */, "handlebars.js"},
 		},
+		{
+			name: "go port of a project",
+			p: pkg.Package{
+				Name:     "github.com/grpc/grpc-go",
+				Type:     pkg.GoModulePkg,
+				Language: pkg.Go,
+			},
+			expected: []string{"grpc-go", "grpc_go", "grpc"},
+		},
+		{
+			name: "gopkg.in module",
+			p: pkg.Package{
+				Name:     "gopkg.in/yaml.v2",
+				Type:     pkg.GoModulePkg,
+				Language: pkg.Go,
+			},
+			expected: []string{"yaml.v2", "yaml"},
+		},
+		{
+			name: "gopkg.in check module",
+			p: pkg.Package{
+				Name:     "gopkg.in/check.v1",
+				Type:     pkg.GoModulePkg,
+				Language: pkg.Go,
+			},
+			expected: []string{"check.v1", "check"},
+		},
+		{
+			name: "gopkg.in mgo module",
+			p: pkg.Package{
+				Name:     "gopkg.in/mgo.v2",
+				Type:     pkg.GoModulePkg,
+				Language: pkg.Go,
+			},
+			expected: []string{"mgo.v2", "mgo"},
+		},
+		{
+			name: "go module without port suffix",
+			p: pkg.Package{
+				Name:     "github.com/sirupsen/logrus",
+				Type:     pkg.GoModulePkg,
+				Language: pkg.Go,
+			},
+			expected: []string{"logrus"},
+		},
+		{
+			name: "embedded tomcat",
+			p: pkg.Package{
+				Name: "tomcat-embed-core",
+				Type: pkg.JavaPkg,
+			},
+			expected: []string{"tomcat" /* <-- known good names | default guess --> */, "tomcat-embed-core", "tomcat_embed_core"},
+		},
+		{
+			name: "jetty server",
+			p: pkg.Package{
+				Name: "jetty-server",
+				Type: pkg.JavaPkg,
+			},
+			expected: []string{"jetty" /* <-- known good names | default guess --> */, "jetty-server", "jetty_server"},
+		},
+		{
+			name: "c++ with cmake prefix",
+			p: pkg.Package{
+				Name:     "cmake-fmt",
+				Type:     pkg.ConanPkg,
+				Language: pkg.CPP,
+			},
+			expected: []string{"cmake-fmt", "cmake_fmt", "fmt", "libfmt"},
+		},
+		{
+			name: "c++ with autotools prefix",
+			p: pkg.Package{
+				Name:     "autotools-libtool",
+				Type:     pkg.ConanPkg,
+				Language: pkg.CPP,
+			},
+			expected: []string{"autotools-libtool", "autotools_libtool", "libtool", "tool"},
+		},
+		{
+			name: "dotnet runtime package",
+			p: pkg.Package{
+				Name: "runtime.linux-x64.Microsoft.NETCore.App",
+				Type: pkg.DotnetPkg,
+			},
+			// note: the dotted name is a namespace, not a domain (e.g. ".app" must not yield netcore)
+			expected: []string{"runtime.linux-x64.Microsoft.NETCore.App", "runtime.linux_x64.Microsoft.NETCore.App", "Microsoft.NETCore.App", "microsoft_netcore_app", "microsoft-netcore-app", "netcore_app", "netcore-app"},
+		},
+		{
+			name: "c library without lib prefix",
+			p: pkg.Package{
+				Name:     "zlib",
+				Type:     pkg.ConanPkg,
+				Language: pkg.CPP,
+			},
+			expected: []string{"zlib", "libzlib", "libz"},
+		},
+		{
+			name: "c library with lib prefix",
+			p: pkg.Package{
+				Name:     "libz",
+				Type:     pkg.ConanPkg,
+				Language: pkg.CPP,
+			},
+			expected: []string{"libz", "zlib"},
+		},
+		{
+			name: "c library with long name",
+			p: pkg.Package{
+				Name:     "libpng",
+				Type:     pkg.ConanPkg,
+				Language: pkg.CPP,
+			},
+			expected: []string{"libpng", "png"},
+		},
+		{
+			name: "dotnet package",
+			p: pkg.Package{
+				Name: "Newtonsoft.Json",
+				Type: pkg.DotnetPkg,
+			},
+			expected: []string{"Newtonsoft.Json", "newtonsoft_json", "newtonsoft-json"},
+		},
+		{
+			name: "dotnet package with several segments",
+			p: pkg.Package{
+				Name: "Microsoft.AspNetCore.Mvc",
+				Type: pkg.DotnetPkg,
+			},
+			expected: []string{"Microsoft.AspNetCore.Mvc", "mvc", "microsoft_aspnetcore_mvc", "microsoft-aspnetcore-mvc", "aspnetcore_mvc", "aspnetcore-mvc"},
+		},
+		{
+			name: "javascript with node prefix",
+			p: pkg.Package{
+				Name: "node-fetch",
+				Type: pkg.NpmPkg,
+			},
+			expected: []string{"node-fetch", "node_fetch", "fetch"},
+		},
 		{
 			name: "gem",
 			p: pkg.Package{
@@ -786,11 +1043,142 @@ func TestCandidateProducts(t *testing.T) {
 			},
 			expected: []string{"rrdtool" /* <-- known good names | default guess --> */, "python-rrdtool", "python_rrdtool"},
 		},
+		{
+			name: "python dotted name",
+			p: pkg.Package{
+				Name:     "ruamel.yaml",
+				Language: pkg.Python,
+				Type:     pkg.PythonPkg,
+			},
+			expected: []string{"ruamel.yaml", "ruamel-yaml", "ruamel_yaml", "python-ruamel.yaml", "python_ruamel.yaml"},
+		},
+		{
+			name: "organization prefix",
+			p: pkg.Package{
+				Name: "apache-tomcat",
+				Type: pkg.RpmPkg,
+			},
+			expected: []string{"apache-tomcat", "apache_tomcat", "tomcat"},
+		},
+		{
+			name: "python package with mixed case",
+			p: pkg.Package{
+				Name:     "Flask-SQLAlchemy",
+				Language: pkg.Python,
+				Type:     pkg.PythonPkg,
+			},
+			expected: []string{"Flask-SQLAlchemy", "Flask_SQLAlchemy", "python-Flask-SQLAlchemy", "python_Flask_SQLAlchemy", "flask-sqlalchemy", "flask_sqlalchemy"},
+		},
+		{
+			name: "python package with dots",
+			p: pkg.Package{
+				Name:     "zope.interface",
+				Language: pkg.Python,
+				Type:     pkg.PythonPkg,
+			},
+			expected: []string{"zope.interface", "python-zope.interface", "python_zope.interface", "zope-interface", "zope_interface"},
+		},
+		{
+			name: "python package with a single word",
+			p: pkg.Package{
+				Name:     "Pillow",
+				Language: pkg.Python,
+				Type:     pkg.PythonPkg,
+			},
+			expected: []string{"Pillow", "python-Pillow", "python_Pillow", "pillow"},
+		},
+		{
+			name: "distribution suffix",
+			p: pkg.Package{
+				Name: "kafka-src",
+				Type: pkg.JavaPkg,
+			},
+			expected: []string{"kafka-src", "kafka_src", "kafka"},
+		},
+		{
+			name: "binary distribution suffix",
+			p: pkg.Package{
+				Name: "hadoop-bin",
+				Type: pkg.RpmPkg,
+			},
+			expected: []string{"hadoop-bin", "hadoop_bin", "hadoop"},
+		},
+		{
+			name: "repeated separators",
+			p: pkg.Package{
+				Name: "foo--bar",
+				Type: pkg.DebPkg,
+			},
+			expected: []string{"foo--bar", "foo__bar", "foo-bar", "foo_bar"},
+		},
+		{
+			name: "library with API version",
+			p: pkg.Package{
+				Name: "libapr-1",
+				Type: pkg.DebPkg,
+			},
+			expected: []string{"libapr-1", "libapr_1", "libapr", "apr"},
+		},
+		{
+			name: "domain name",
+			p: pkg.Package{
+				Name: "curl.se",
+				Type: pkg.DebPkg,
+			},
+			expected: []string{"curl.se", "curl"},
+		},
+		{
+			name: "scoped npm package",
+			p: pkg.Package{
+				Name: "@angular/core",
+				Type: pkg.NpmPkg,
+			},
+			expected: []string{"core", "angular", "angular_core", "angular-core"},
+		},
+		{
+			name: "scoped npm package from an organization",
+			p: pkg.Package{
+				Name: "@babel/parser",
+				Type: pkg.NpmPkg,
+			},
+			expected: []string{"parser", "babel", "babel_parser", "babel-parser"},
+		},
+		{
+			name: "unscoped npm package",
+			p: pkg.Package{
+				Name: "node-fetch",
+				Type: pkg.NpmPkg,
+			},
+			expected: []string{"node-fetch", "node_fetch", "fetch"},
+		},
+		{
+			name: "leading and trailing separators",
+			p: pkg.Package{
+				Name: "-foo-",
+				Type: pkg.DebPkg,
+			},
+			expected: []string{"-foo-", "_foo_", "foo"},
+		},
+		{
+			// note: there is no hex package type, however, the underscore separated names are handled the same for any type
+			name: "underscore separated hex package",
+			p: pkg.Package{
+				Name: "ecto_sql",
+			},
+			expected: []string{"ecto_sql", "ecto-sql"},
+		},
+		{
+			name: "single word hex package",
+			p: pkg.Package{
+				Name: "phoenix",
+			},
+			expected: []string{"phoenix"},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.ElementsMatch(t, test.expected, candidateProducts(test.p))
+			assert.ElementsMatch(t, test.expected, candidateProducts(test.p, DefaultConfig()))
 		})
 	}
 }
@@ -817,11 +1205,173 @@ func TestCandidateVendor(t *testing.T) {
 			},
 			expected: []string{"apache" /* <-- known good names | default guess --> */, "log4j"},
 		},
+		{
+			name: "tomcat",
+			p: pkg.Package{
+				Name: "tomcat-catalina",
+				Type: pkg.JavaPkg,
+			},
+			expected: []string{"apache" /* <-- known good names | default guess --> */, "tomcat", "tomcat-catalina", "tomcat_catalina"},
+		},
+		{
+			name: "ecto_sql",
+			p: pkg.Package{
+				Name: "ecto_sql",
+			},
+			expected: []string{"ecto", "ecto_sql", "ecto-sql"},
+		},
+		{
+			name: "phoenix",
+			p: pkg.Package{
+				Name: "phoenix",
+			},
+			expected: []string{"phoenix"},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(fmt.Sprintf("%+v %+v", test.p, test.expected), func(t *testing.T) {
-			assert.ElementsMatch(t, test.expected, candidateVendors(test.p))
+			assert.ElementsMatch(t, test.expected, candidateVendors(test.p, DefaultConfig()))
+		})
+	}
+}
+
+func TestCandidateVendor_ExcludeProductVendors(t *testing.T) {
+	p := pkg.Package{
+		Name:         "name",
+		Language:     pkg.Python,
+		Type:         pkg.PythonPkg,
+		MetadataType: pkg.PythonPackageMetadataType,
+		Metadata: pkg.PythonPackageMetadata{
+			Author: "alex goodman",
+		},
+	}
+
+	assert.ElementsMatch(t, []string{"name", "python-name", "python_name", "python", "alex_goodman"}, candidateVendors(p, Config{}))
+	assert.ElementsMatch(t, []string{"alex_goodman"}, candidateVendors(p, Config{ExcludeProductVendors: true}))
+}
+
+func TestCandidateVendor_MaxSubSelections(t *testing.T) {
+	p := pkg.Package{
+		Name: "a-b-c-d-e",
+		Type: pkg.DebPkg,
+	}
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected []string
+	}{
+		{
+			name: "within the default limit",
+			cfg:  Config{},
+			expected: []string{
+				"a-b-c-d-e", "a_b_c_d_e",
+				"a", "a-b", "a-b-c", "a-b-c-d",
+				"a_b", "a_b_c", "a_b_c_d",
+			},
+		},
+		{
+			name: "limited",
+			cfg:  Config{MaxSubSelections: 2},
+			expected: []string{
+				"a-b-c-d-e", "a_b_c_d_e",
+				"a",
+			},
+		},
+		{
+			name: "explicitly unlimited",
+			cfg:  Config{MaxSubSelections: -1},
+			expected: []string{
+				"a-b-c-d-e", "a_b_c_d_e",
+				"a", "a-b", "a-b-c", "a-b-c-d",
+				"a_b", "a_b_c", "a_b_c_d",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, candidateVendors(p, test.cfg))
+		})
+	}
+}
+
+func TestCandidateVendor_DefaultMaxSubSelections(t *testing.T) {
+	p := pkg.Package{
+		Name: "a-b-c-d-e-f-g-h-i-j-k-l",
+		Type: pkg.DebPkg,
+	}
+
+	// only the field, the separator variation, and the first token remain
+	assert.ElementsMatch(t, []string{"a-b-c-d-e-f-g-h-i-j-k-l", "a_b_c_d_e_f_g_h_i_j_k_l", "a"}, candidateVendors(p, Config{}))
+	assert.Len(t, candidateVendors(p, Config{MaxSubSelections: -1}), 23)
+}
+
+func TestCandidateVendor_VendorSeparatorPreference(t *testing.T) {
+	p := pkg.Package{
+		Name: "jenkins-ci",
+		Type: pkg.DebPkg,
+	}
+
+	tests := []struct {
+		name       string
+		preference []string
+		expected   []string
+	}{
+		{
+			name:     "all variants",
+			expected: []string{"jenkins", "jenkins-ci", "jenkins_ci"},
+		},
+		{
+			name:       "prefer hyphen",
+			preference: []string{"-", "_", ""},
+			expected:   []string{"jenkins", "jenkins-ci"},
+		},
+		{
+			name:       "prefer underscore",
+			preference: []string{"_", "-", ""},
+			expected:   []string{"jenkins", "jenkins_ci"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, candidateVendors(p, Config{VendorSeparatorPreference: test.preference}))
+		})
+	}
+}
+
+func Test_keepCanonicalSeparatorVariants(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     []string
+		preference []string
+		expected   []string
+	}{
+		{
+			name:       "hyphen over underscore over none",
+			values:     []string{"jenkinsci", "jenkins_ci", "jenkins-ci", "jenkins"},
+			preference: []string{"-", "_", ""},
+			expected:   []string{"jenkins-ci", "jenkins"},
+		},
+		{
+			name:       "none over hyphen",
+			values:     []string{"jenkins-ci", "jenkinsci"},
+			preference: []string{"", "-"},
+			expected:   []string{"jenkinsci"},
+		},
+		{
+			name:       "longer value wins when no separator is preferred",
+			values:     []string{"jenkinsci", "jenkins-ci"},
+			preference: []string{"_"},
+			expected:   []string{"jenkins-ci"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, keepCanonicalSeparatorVariants(test.values, test.preference))
 		})
 	}
 }
@@ -905,3 +1455,322 @@ func Test_addSeparatorVariations(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateWithConfig_PreserveCase(t *testing.T) {
+	p := pkg.Package{
+		Name:    "RedCloth",
+		Version: "4.2.9-RC1",
+		Type:    pkg.RpmPkg,
+	}
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:redcloth:redcloth:4.2.9-rc1:*:*:*:*:*:*:*",
+		"cpe:2.3:a:redcloth:redcloth:4.2.9:*:*:*:*:*:*:*",
+	}, cpeStrings(GenerateWithConfig(p, Config{})))
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:RedCloth:RedCloth:4.2.9-RC1:*:*:*:*:*:*:*",
+		"cpe:2.3:a:RedCloth:RedCloth:4.2.9:*:*:*:*:*:*:*",
+	}, cpeStrings(GenerateWithConfig(p, Config{PreserveCase: true})))
+}
+
+func TestGenerateWithConfig_Presets(t *testing.T) {
+	p := pkg.Package{
+		Name:         "spring-security-core",
+		Version:      "5.7.3",
+		Language:     pkg.Java,
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			PomProperties: &pkg.PomProperties{
+				GroupID:    "org.springframework.security",
+				ArtifactID: "spring-security-core",
+				Version:    "5.7.3",
+			},
+		},
+	}
+
+	greedy := GenerateWithConfig(p, DefaultConfig().WithPreset(GreedyPreset))
+	conservative := GenerateWithConfig(p, DefaultConfig().WithPreset(ConservativePreset))
+
+	// the greedy preset is the default behavior
+	assert.Equal(t, cpeStrings(Generate(p)), cpeStrings(greedy))
+
+	assert.NotEmpty(t, conservative)
+	assert.Less(t, len(conservative), len(greedy))
+	assert.Subset(t, cpeStrings(greedy), cpeStrings(conservative))
+	assert.Contains(t, cpeStrings(conservative), "cpe:2.3:a:springframework:spring-security-core:5.7.3:*:*:*:*:*:*:*")
+}
+
+func TestConfig_WithPreset(t *testing.T) {
+	cfg := Config{IncludeTargetSoftware: true}
+
+	conservative := cfg.WithPreset(ConservativePreset)
+	assert.True(t, conservative.ExcludeProductVendors)
+	assert.True(t, conservative.ExcludeSubSelections)
+	assert.True(t, conservative.ExcludeDelimiterVariations)
+	// unrelated options are left as-is
+	assert.True(t, conservative.IncludeTargetSoftware)
+
+	assert.Equal(t, cfg, conservative.WithPreset(GreedyPreset))
+	assert.Equal(t, cfg, cfg.WithPreset("unknown"))
+}
+
+func TestGenerateWithConfig_PreserveCaseDeduplication(t *testing.T) {
+	// the package name and the normalized name are both product candidates, which only differ by case
+	p := pkg.Package{
+		Name:     "Spring",
+		Version:  "5.3.23",
+		Language: pkg.Python,
+		Type:     pkg.PythonPkg,
+	}
+
+	actual := cpeStrings(GenerateWithConfig(p, Config{PreserveCase: true}))
+
+	var matches []string
+	for _, c := range actual {
+		if strings.EqualFold(c, "cpe:2.3:a:spring:spring:5.3.23:*:*:*:*:*:*:*") {
+			matches = append(matches, c)
+		}
+	}
+	assert.Equal(t, []string{"cpe:2.3:a:Spring:Spring:5.3.23:*:*:*:*:*:*:*"}, matches)
+
+	seen := strset.New()
+	for _, c := range actual {
+		if seen.Has(strings.ToLower(c)) {
+			t.Errorf("duplicate CPE (ignoring case): %s", c)
+		}
+		seen.Add(strings.ToLower(c))
+	}
+}
+
+func TestGenerateWithConfig_PostProcess(t *testing.T) {
+	p := pkg.Package{
+		Name:    "widgets",
+		Version: "1.0",
+		Type:    pkg.GemPkg,
+	}
+
+	cfg := Config{
+		PostProcess: func(cpes []pkg.CPE, p pkg.Package) []pkg.CPE {
+			// append a CPE that is more specific than all generated CPEs, which should be sorted first
+			return append(cpes, pkg.MustCPE("cpe:2.3:a:acme:acme_widgets:"+p.Version+":*:*:*:*:ruby:*:*"))
+		},
+	}
+
+	expected := []string{
+		"cpe:2.3:a:acme:acme_widgets:1.0:*:*:*:*:ruby:*:*",
+		"cpe:2.3:a:widgets:widgets:1.0:*:*:*:*:*:*:*",
+	}
+
+	assert.Equal(t, expected[1:], cpeStrings(Generate(p)))
+	assert.Equal(t, expected, cpeStrings(GenerateWithConfig(p, cfg)))
+}
+
+func TestGeneratePackageCPEs_OrganizationPrefix(t *testing.T) {
+	p := pkg.Package{
+		Name:    "apache-tomcat",
+		Version: "9.0.43",
+		Type:    pkg.RpmPkg,
+	}
+
+	actual := cpeStrings(Generate(p))
+	assert.Contains(t, actual, "cpe:2.3:a:apache:tomcat:9.0.43:*:*:*:*:*:*:*")
+
+	p.Name = "apache-commons-text"
+	actual = cpeStrings(Generate(p))
+	assert.Contains(t, actual, "cpe:2.3:a:apache:commons-text:9.0.43:*:*:*:*:*:*:*")
+}
+
+// benchmarkPackages is a mix of package types, names, and metadata that resembles a typical catalog
+var benchmarkPackages = []pkg.Package{
+	{
+		Name:         "log4j-core",
+		Version:      "2.14.1",
+		Language:     pkg.Java,
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			Manifest: &pkg.JavaManifest{
+				Main: map[string]string{
+					"Implementation-Vendor": "The Apache Software Foundation",
+					"Bundle-SymbolicName":   "org.apache.logging.log4j.core",
+				},
+			},
+			PomProperties: &pkg.PomProperties{
+				GroupID:    "org.apache.logging.log4j",
+				ArtifactID: "log4j-core",
+				Version:    "2.14.1",
+			},
+		},
+	},
+	{
+		Name:         "requests",
+		Version:      "2.28.1",
+		Language:     pkg.Python,
+		Type:         pkg.PythonPkg,
+		MetadataType: pkg.PythonPackageMetadataType,
+		Metadata: pkg.PythonPackageMetadata{
+			Author:      "Kenneth Reitz",
+			AuthorEmail: "me@kennethreitz.org",
+		},
+	},
+	{
+		Name:     "@babel/core",
+		Version:  "7.19.3",
+		Language: pkg.JavaScript,
+		Type:     pkg.NpmPkg,
+	},
+	{
+		Name:     "github.com/sirupsen/logrus",
+		Version:  "v1.9.0",
+		Language: pkg.Go,
+		Type:     pkg.GoModulePkg,
+	},
+	{
+		Name:         "libssl1.1",
+		Version:      "1.1.1n-0+deb11u3",
+		Type:         pkg.DebPkg,
+		MetadataType: pkg.DpkgMetadataType,
+		Metadata: pkg.DpkgMetadata{
+			Package: "libssl1.1",
+			Source:  "openssl",
+		},
+	},
+	{
+		Name:         "ca-certificates-bundle",
+		Version:      "20220614-r0",
+		Type:         pkg.ApkPkg,
+		MetadataType: pkg.ApkMetadataType,
+		Metadata: pkg.ApkMetadata{
+			Package:       "ca-certificates-bundle",
+			OriginPackage: "ca-certificates",
+		},
+	},
+	{
+		Name:     "actionpack",
+		Version:  "7.0.4",
+		Language: pkg.Ruby,
+		Type:     pkg.GemPkg,
+	},
+}
+
+func TestCandidateProducts_BenchmarkPackagesParity(t *testing.T) {
+	// captured before the allocation reductions to fieldCandidateSet (updated since for scoped npm packages), the output
+	// must remain identical
+	expected := map[string][]string{
+		"log4j-core":                 {"core", "log4j", "log4j-core", "log4j_core"},
+		"requests":                   {"python-requests", "python_requests", "requests"},
+		"@babel/core":                {"babel", "babel-core", "babel_core", "core"},
+		"github.com/sirupsen/logrus": {"logrus"},
+		"libssl1.1":                  {"libssl", "libssl1.1", "ssl"},
+		"ca-certificates-bundle":     {"ca-certificates-bundle", "ca_certificates_bundle"},
+		"actionpack":                 {"actionpack"},
+	}
+
+	for _, p := range benchmarkPackages {
+		t.Run(p.Name, func(t *testing.T) {
+			assert.ElementsMatch(t, expected[p.Name], candidateProducts(p, DefaultConfig()))
+		})
+	}
+}
+
+func Benchmark_candidateProducts(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, p := range benchmarkPackages {
+			candidateProducts(p, DefaultConfig())
+		}
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, p := range benchmarkPackages {
+			Generate(p)
+		}
+	}
+}
+
+func BenchmarkGenerate_ManySeparators(b *testing.B) {
+	p := pkg.Package{
+		Name:    "a-b-c-d-e-f-g-h-i-j-k-l",
+		Version: "1.0.0",
+		Type:    pkg.DebPkg,
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Generate(p)
+	}
+}
+
+func cpeStrings(cpes []pkg.CPE) (results []string) {
+	for _, c := range cpes {
+		results = append(results, pkg.CPEString(c))
+	}
+	return results
+}
+
+func TestGenerateWithConfig_Observer(t *testing.T) {
+	p := pkg.Package{
+		Name:     "github.com/spf13/cobra",
+		Version:  "v1.6.1",
+		Language: pkg.Go,
+		Type:     pkg.GoModulePkg,
+	}
+
+	var observed []Metrics
+	cfg := DefaultConfig()
+	cfg.Observer = func(actual pkg.Package, metrics Metrics) {
+		assert.Equal(t, p.Name, actual.Name)
+		observed = append(observed, metrics)
+	}
+
+	assert.Equal(t, Generate(p), GenerateWithConfig(p, cfg), "the observer must not alter the generated CPEs")
+	assert.Equal(t, []Metrics{
+		{
+			Vendors:        1,
+			Products:       1,
+			TargetSoftware: 1,
+			CandidateCPEs:  1,
+			CPEs:           1,
+		},
+	}, observed)
+}
+
+func TestGenerate_Concurrent(t *testing.T) {
+	var expected [][]string
+	for _, p := range benchmarkPackages {
+		expected = append(expected, cpeStrings(Generate(p)))
+	}
+
+	actual := make([][]string, len(benchmarkPackages))
+	var wg sync.WaitGroup
+	for i, p := range benchmarkPackages {
+		wg.Add(1)
+		go func(i int, p pkg.Package) {
+			defer wg.Done()
+			actual[i] = cpeStrings(Generate(p))
+		}(i, p)
+	}
+	wg.Wait()
+
+	assert.Equal(t, expected, actual)
+}
+
+func ExampleGenerate() {
+	// packages do not need to come from a catalog, only the fields describing the package identity are used
+	p := pkg.Package{
+		Name:     "github.com/spf13/cobra",
+		Version:  "v1.6.1",
+		Language: pkg.Go,
+		Type:     pkg.GoModulePkg,
+	}
+
+	for _, c := range Generate(p) {
+		fmt.Println(pkg.CPEString(c))
+	}
+	// Output: cpe:2.3:a:spf13:cobra:v1.6.1:*:*:*:*:*:*:*
+}
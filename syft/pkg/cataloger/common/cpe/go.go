@@ -2,9 +2,40 @@ package cpe
 
 import (
 	"net/url"
+	"regexp"
 	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/facebookincubator/nvdtools/wfn"
 )
 
+// goGiteaHosts are the public Gitea (and Gitea fork) instances, where modules are always under an owner/repo path. Since
+// these hosts do not always serve go-get metadata, the repository may carry the VCS suffix (e.g. codeberg.org/owner/repo.git).
+var goGiteaHosts = []string{"codeberg.org", "gitea.com"}
+
+// goOrgVanityHosts are the go.<org> vanity domains that organizations use to host all of their modules, where the
+// organization owns the modules in NVD (e.g. go.uber.org/zap -> uber).
+var goOrgVanityHosts = []string{
+	"go.elastic.co",
+	"go.etcd.io",
+	"go.mongodb.org",
+	"go.mozilla.org",
+	"go.opencensus.io",
+	"go.opentelemetry.io",
+	"go.temporal.io",
+	"go.uber.org",
+}
+
+// goStdlibNames are the package names used for detections of the go standard library and toolchain, which are
+// described in NVD by the toolchain CPE (e.g. cpe:2.3:a:golang:go).
+var goStdlibNames = []string{"stdlib", "std", "go", "golang.org/toolchain"}
+
+// goPkgInVersionSuffixPattern matches the major version suffix of a gopkg.in module path (e.g. the .v2 in yaml.v2)
+var goPkgInVersionSuffixPattern = regexp.MustCompile(`\.v[0-9]+$`)
+
+// goToolchainVersionPattern matches release toolchain versions (e.g. go1.20.3 or go1.19.1 X:boringcrypto)
+var goToolchainVersionPattern = regexp.MustCompile(`^go(\d+\.\d+(\.\d+)?)`)
+
 // candidateProductForGo attempts to find a single product name in a best-effort attempt. This implementation prefers
 // to return no vendor over returning potentially nonsensical results.
 func candidateProductForGo(name string) string {
@@ -17,14 +48,23 @@ func candidateProductForGo(name string) string {
 	cleanPath := strings.Trim(u.Path, "/")
 	pathElements := strings.Split(cleanPath, "/")
 
-	switch u.Host {
-	case "golang.org", "gopkg.in":
+	switch {
+	case u.Host == "golang.org" || u.Host == "gopkg.in":
 		return cleanPath
-	case "google.golang.org":
+	case u.Host == "google.golang.org":
+		return pathElements[0]
+	case isGoGiteaHost(u.Host) && len(pathElements) > 1:
+		pathElements[1] = strings.TrimSuffix(pathElements[1], ".git")
+	case goOrgVanityOwner(u.Host) != "":
+		// the host is the owner, so the project is named by the first path element (e.g. go.etcd.io/etcd/client/v3 -> etcd)
 		return pathElements[0]
 	}
 
 	if len(pathElements) < 2 {
+		if isGoVanityHost(u.Host) && pathElements[0] != "" {
+			// single-word vanity imports are named by the only path element (e.g. rsc.io/quote -> quote)
+			return pathElements[0]
+		}
 		return ""
 	}
 
@@ -53,9 +93,116 @@ func candidateVendorForGo(name string) string {
 		return ""
 	}
 
+	if owner := goOrgVanityOwner(u.Host); owner != "" {
+		// the organization owns all modules under its vanity domain (e.g. go.uber.org/zap -> uber)
+		return owner
+	}
+
 	pathElements := strings.Split(cleanPath, "/")
 	if len(pathElements) < 2 {
+		if isGoVanityHost(u.Host) && pathElements[0] != "" {
+			// single-word vanity imports are owned by the vanity domain (e.g. rsc.io/quote -> rsc)
+			return strings.Split(u.Host, ".")[0]
+		}
 		return ""
 	}
 	return pathElements[0]
 }
+
+// isGoStdlib indicates if the given package name is a detection of the go standard library or toolchain.
+func isGoStdlib(name string) bool {
+	for _, n := range goStdlibNames {
+		if strings.EqualFold(name, n) {
+			return true
+		}
+	}
+	return false
+}
+
+func isGoGiteaHost(host string) bool {
+	for _, h := range goGiteaHosts {
+		if strings.EqualFold(host, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// goOrgVanityOwner returns the organization for a known go.<org> vanity domain (e.g. go.uber.org -> uber). An empty
+// string is returned for all other hosts.
+func goOrgVanityOwner(host string) string {
+	for _, h := range goOrgVanityHosts {
+		if strings.EqualFold(host, h) {
+			return strings.Split(h, ".")[1]
+		}
+	}
+	return ""
+}
+
+// isGoVanityHost indicates if the given host is a personal vanity domain, which commonly host modules directly under
+// the root path (e.g. rsc.io/quote).
+func isGoVanityHost(host string) bool {
+	fields := strings.Split(host, ".")
+	if len(fields) != 2 {
+		return false
+	}
+	switch fields[1] {
+	case "io", "cc":
+		return true
+	}
+	return false
+}
+
+// trimGoPortSuffix removes any suffix that is commonly used to name the go port of a project (e.g. grpc-go -> grpc).
+func trimGoPortSuffix(product string) string {
+	for _, suffix := range []string{"-golang", "-go"} {
+		if strings.HasSuffix(product, suffix) {
+			return strings.TrimSuffix(product, suffix)
+		}
+	}
+	return product
+}
+
+// trimGoPkgInVersionSuffix removes the trailing major version suffix from a product derived from a gopkg.in module,
+// which NVD does not include in the product name (e.g. gopkg.in/yaml.v2 -> yaml). Products of modules from all other
+// hosts are returned as-is.
+func trimGoPkgInVersionSuffix(name, product string) string {
+	if !strings.HasPrefix(name, "gopkg.in/") {
+		return product
+	}
+	return goPkgInVersionSuffixPattern.ReplaceAllString(product, "")
+}
+
+// goToolchainCPE returns the CPE for the go toolchain that compiled the binary the given package was found in.
+func goToolchainCPE(p pkg.Package) *pkg.CPE {
+	metadata, ok := p.Metadata.(pkg.GolangBinMetadata)
+	if !ok {
+		return nil
+	}
+
+	version := goToolchainVersion(metadata.GoCompiledVersion)
+	if version == "" {
+		return nil
+	}
+	return newCPE("go", "golang", version, wfn.Any, wfn.Any)
+}
+
+// goToolchainVersion extracts the release version from a go toolchain version string (e.g. go1.20.3 -> 1.20.3).
+// Development toolchains (e.g. devel go1.21-abc123) do not have a release version and yield an empty string.
+func goToolchainVersion(toolchain string) string {
+	match := goToolchainVersionPattern.FindStringSubmatch(strings.TrimSpace(toolchain))
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// normalizeGoVersion converts a go module version with "+incompatible" build metadata into the form used by NVD,
+// dropping the metadata along with the "v" prefix (e.g. v2.0.0+incompatible -> 2.0.0). All other versions are returned
+// as-is.
+func normalizeGoVersion(version string) string {
+	if !strings.HasSuffix(version, "+incompatible") {
+		return version
+	}
+	return strings.TrimPrefix(strings.TrimSuffix(version, "+incompatible"), "v")
+}
@@ -3,6 +3,7 @@ package cpe
 import (
 	"testing"
 
+	"github.com/anchore/syft/syft/pkg"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -47,6 +48,63 @@ func TestCandidateProductForGo(t *testing.T) {
 			pkg:      "",
 			expected: "",
 		},
+		{
+			pkg:      "rsc.io/quote",
+			expected: "quote",
+		},
+		{
+			pkg:      "codeberg.org/someone/something",
+			expected: "something",
+		},
+		{
+			pkg:      "codeberg.org/someone/something.git/sub",
+			expected: "something/sub",
+		},
+		{
+			pkg:      "gitea.com/someone/something",
+			expected: "something",
+		},
+		{
+			pkg:      "codeberg.org/someone",
+			expected: "",
+		},
+		{
+			pkg:      "place.io/",
+			expected: "",
+		},
+		{
+			pkg:      "github.com/spf13/cobra",
+			expected: "cobra",
+		},
+		{
+			pkg:      "go.uber.org/zap",
+			expected: "zap",
+		},
+		{
+			pkg:      "go.mongodb.org/mongo-driver",
+			expected: "mongo-driver",
+		},
+		{
+			pkg:      "go.opentelemetry.io/otel/sdk",
+			expected: "otel",
+		},
+		{
+			pkg:      "go.etcd.io/etcd/client/v3",
+			expected: "etcd",
+		},
+		{
+			pkg:      "go.uber.org/zap/zapcore",
+			expected: "zap",
+		},
+		{
+			pkg:      "go.uber.org/",
+			expected: "",
+		},
+		{
+			// only known organization vanity domains are considered
+			pkg:      "go.example.org/acme/widgets",
+			expected: "widgets",
+		},
 	}
 
 	for _, test := range tests {
@@ -93,6 +151,50 @@ func TestCandidateVendorForGo(t *testing.T) {
 			pkg:      "github.com/someone/something/long/package/name",
 			expected: "someone",
 		},
+		{
+			pkg:      "rsc.io/quote",
+			expected: "rsc",
+		},
+		{
+			pkg:      "codeberg.org/someone/something",
+			expected: "someone",
+		},
+		{
+			pkg:      "gitea.com/someone/something",
+			expected: "someone",
+		},
+		{
+			pkg:      "place.io/",
+			expected: "",
+		},
+		{
+			pkg:      "github.com/spf13/cobra",
+			expected: "spf13",
+		},
+		{
+			pkg:      "go.uber.org/zap",
+			expected: "uber",
+		},
+		{
+			pkg:      "go.mongodb.org/mongo-driver",
+			expected: "mongodb",
+		},
+		{
+			pkg:      "go.opentelemetry.io/otel/sdk",
+			expected: "opentelemetry",
+		},
+		{
+			pkg:      "go.place.example.com/thing",
+			expected: "",
+		},
+		{
+			pkg:      "go.etcd.io/etcd/client/v3",
+			expected: "etcd",
+		},
+		{
+			pkg:      "go.example.org/acme/widgets",
+			expected: "acme",
+		},
 	}
 
 	for _, test := range tests {
@@ -101,3 +203,215 @@ func TestCandidateVendorForGo(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGoStdlib(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "stdlib", expected: true},
+		{name: "std", expected: true},
+		{name: "go", expected: true},
+		{name: "golang.org/toolchain", expected: true},
+		{name: "golang.org/x/net"},
+		{name: "github.com/golang/go"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isGoStdlib(test.name))
+		})
+	}
+}
+
+func TestTrimGoPkgInVersionSuffix(t *testing.T) {
+	tests := []struct {
+		name     string
+		product  string
+		expected string
+	}{
+		{
+			name:     "gopkg.in/yaml.v2",
+			product:  "yaml.v2",
+			expected: "yaml",
+		},
+		{
+			name:     "gopkg.in/check.v1",
+			product:  "check.v1",
+			expected: "check",
+		},
+		{
+			name:     "gopkg.in/mgo.v2",
+			product:  "mgo.v2",
+			expected: "mgo",
+		},
+		{
+			name:     "gopkg.in/go-playground/validator.v9",
+			product:  "go-playground/validator.v9",
+			expected: "go-playground/validator",
+		},
+		{
+			name:     "gopkg.in/natefinch/lumberjack.v2",
+			product:  "natefinch/lumberjack.v2",
+			expected: "natefinch/lumberjack",
+		},
+		{
+			name:     "gopkg.in/square/go-jose.v2.cryptosigner",
+			product:  "square/go-jose.v2.cryptosigner",
+			expected: "square/go-jose.v2.cryptosigner",
+		},
+		{
+			name:     "github.com/someone/something.v2",
+			product:  "something.v2",
+			expected: "something.v2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, trimGoPkgInVersionSuffix(test.name, test.product))
+		})
+	}
+}
+
+func TestNormalizeGoVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{
+			version:  "v2.0.0+incompatible",
+			expected: "2.0.0",
+		},
+		{
+			version:  "v1.2.3",
+			expected: "v1.2.3",
+		},
+		{
+			version:  "v0.0.0-20220722155238-128564f6959c",
+			expected: "v0.0.0-20220722155238-128564f6959c",
+		},
+		{
+			version:  "1.2.3",
+			expected: "1.2.3",
+		},
+		{
+			version:  "(devel)",
+			expected: "(devel)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			assert.Equal(t, test.expected, normalizeGoVersion(test.version))
+		})
+	}
+}
+
+func TestGoToolchainVersion(t *testing.T) {
+	tests := []struct {
+		toolchain string
+		expected  string
+	}{
+		{
+			toolchain: "go1.20.3",
+			expected:  "1.20.3",
+		},
+		{
+			toolchain: "go1.19",
+			expected:  "1.19",
+		},
+		{
+			toolchain: "go1.18.5 X:boringcrypto",
+			expected:  "1.18.5",
+		},
+		{
+			toolchain: "devel go1.21-3e35df5edb Tue Apr 4 21:13:04 2023 +0000",
+			expected:  "",
+		},
+		{
+			toolchain: "",
+			expected:  "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.toolchain, func(t *testing.T) {
+			assert.Equal(t, test.expected, goToolchainVersion(test.toolchain))
+		})
+	}
+}
+
+func TestGoToolchainCPE(t *testing.T) {
+	p := pkg.Package{
+		Name:         "github.com/someone/something",
+		Version:      "v1.0.0",
+		Language:     pkg.Go,
+		Type:         pkg.GoModulePkg,
+		MetadataType: pkg.GolangBinMetadataType,
+		Metadata: pkg.GolangBinMetadata{
+			GoCompiledVersion: "go1.20.3",
+		},
+	}
+
+	actual := goToolchainCPE(p)
+	if assert.NotNil(t, actual) {
+		assert.Equal(t, "cpe:2.3:a:golang:go:1.20.3:*:*:*:*:*:*:*", pkg.CPEString(*actual))
+	}
+
+	assert.NotContains(t, cpeStrings(GenerateWithConfig(p, Config{})), "cpe:2.3:a:golang:go:1.20.3:*:*:*:*:*:*:*")
+	assert.Contains(t, cpeStrings(GenerateWithConfig(p, Config{IncludeGoToolchain: true})), "cpe:2.3:a:golang:go:1.20.3:*:*:*:*:*:*:*")
+}
+
+func TestGeneratePackageCPEs_GoMainModule(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "released main module",
+			p: pkg.Package{
+				Name:         "github.com/anchore/syft",
+				Version:      "v0.60.0",
+				Language:     pkg.Go,
+				Type:         pkg.GoModulePkg,
+				MetadataType: pkg.GolangBinMetadataType,
+				Metadata: pkg.GolangBinMetadata{
+					MainModule: "github.com/anchore/syft",
+				},
+			},
+			expected: []string{"cpe:2.3:a:anchore:syft:v0.60.0:*:*:*:*:*:*:*"},
+		},
+		{
+			// there is no version to describe a local build with, however, the vendor and product are still known
+			name: "locally built main module",
+			p: pkg.Package{
+				Name:         "github.com/anchore/syft",
+				Version:      "(devel)",
+				Language:     pkg.Go,
+				Type:         pkg.GoModulePkg,
+				MetadataType: pkg.GolangBinMetadataType,
+				Metadata: pkg.GolangBinMetadata{
+					MainModule: "github.com/anchore/syft",
+				},
+			},
+			expected: []string{"cpe:2.3:a:anchore:syft:*:*:*:*:*:*:*:*"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, cpeStrings(Generate(test.p)))
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_GoStdlib(t *testing.T) {
+	p := pkg.Package{
+		Name:     "stdlib",
+		Version:  "1.20.3",
+		Language: pkg.Go,
+		Type:     pkg.GoModulePkg,
+	}
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:golang:go:1.20.3:*:*:*:*:*:*:*",
+		"cpe:2.3:a:go:go:1.20.3:*:*:*:*:*:*:*",
+	}, cpeStrings(Generate(p)))
+}
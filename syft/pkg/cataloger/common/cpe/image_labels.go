@@ -0,0 +1,32 @@
+package cpe
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/facebookincubator/nvdtools/wfn"
+)
+
+// see https://github.com/opencontainers/image-spec/blob/main/annotations.md#pre-defined-annotation-keys
+const (
+	ociImageVendorLabel  = "org.opencontainers.image.vendor"
+	ociImageTitleLabel   = "org.opencontainers.image.title"
+	ociImageVersionLabel = "org.opencontainers.image.version"
+)
+
+// FromImageLabels creates an application CPE that describes a container image itself from the OCI image labels
+// (vendor, title, and version). A nil CPE is returned if there is not enough label information to describe the image.
+func FromImageLabels(labels map[string]string) *pkg.CPE {
+	product := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(labels[ociImageTitleLabel])), " ", "_")
+	version := strings.TrimSpace(labels[ociImageVersionLabel])
+	if product == "" || version == "" {
+		return nil
+	}
+
+	vendor := normalizeName(labels[ociImageVendorLabel])
+	if vendor == "" {
+		vendor = wfn.Any
+	}
+
+	return newCPE(product, vendor, version, wfn.Any, wfn.Any)
+}
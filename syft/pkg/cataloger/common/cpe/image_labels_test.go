@@ -0,0 +1,62 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromImageLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		expected string
+	}{
+		{
+			name: "all labels",
+			labels: map[string]string{
+				"org.opencontainers.image.vendor":  "Acme, Inc.",
+				"org.opencontainers.image.title":   "Widget Server",
+				"org.opencontainers.image.version": "2.1.0",
+				"maintainer":                       "someone@acme.example",
+			},
+			expected: "cpe:2.3:a:acme:widget_server:2.1.0:*:*:*:*:*:*:*",
+		},
+		{
+			name: "missing vendor",
+			labels: map[string]string{
+				"org.opencontainers.image.title":   "widget",
+				"org.opencontainers.image.version": "2.1.0",
+			},
+			expected: "cpe:2.3:a:*:widget:2.1.0:*:*:*:*:*:*:*",
+		},
+		{
+			name: "missing version",
+			labels: map[string]string{
+				"org.opencontainers.image.vendor": "Acme, Inc.",
+				"org.opencontainers.image.title":  "widget",
+			},
+		},
+		{
+			name: "missing title",
+			labels: map[string]string{
+				"org.opencontainers.image.vendor":  "Acme, Inc.",
+				"org.opencontainers.image.version": "2.1.0",
+			},
+		},
+		{
+			name: "no labels",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := FromImageLabels(test.labels)
+			if test.expected == "" {
+				assert.Nil(t, actual)
+				return
+			}
+			assert.Equal(t, test.expected, pkg.CPEString(*actual))
+		})
+	}
+}
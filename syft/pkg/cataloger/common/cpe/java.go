@@ -1,6 +1,7 @@
 package cpe
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/anchore/syft/internal"
@@ -38,16 +39,62 @@ var (
 		"Specification-Vendor",
 		"Implementation-Vendor",
 	}
+	// javaManifestVendorIDFields are the MANIFEST.MF fields that identify the vendor in reverse-DNS form, which are
+	// considered for any top-level domain when there is no pom.properties
+	javaManifestVendorIDFields = []string{
+		"Implementation-Vendor-Id",
+		"Bundle-SymbolicName",
+	}
+
+	// reverseDNSPattern matches reverse-DNS names with at least two labels (e.g. ch.qos.logback), which excludes
+	// free-form values (e.g. "Oracle Corporation")
+	reverseDNSPattern = regexp.MustCompile(`^[a-z][a-z0-9-]*(\.[a-zA-Z_][a-zA-Z0-9_-]*)+$`)
+
+	// javaPlatformNamespaces are reverse-DNS-like namespaces of the java platform itself, which say nothing about the vendor
+	javaPlatformNamespaces = strset.New("java", "javax", "jakarta", "jdk", "sun")
+
+	// javaVariantClassifiers are version suffixes that indicate the platform flavor of an artifact, not a different
+	// release (e.g. guava 31.1-jre and 31.1-android are both the 31.1 release)
+	javaVariantClassifiers = []string{"-jre", "-android"}
+
+	// javaSnapshotVersionPattern matches maven snapshot versions, either unresolved (1.0-SNAPSHOT) or resolved to a
+	// timestamped build when deployed to a repository (1.0-20230101.120000-3)
+	javaSnapshotVersionPattern = regexp.MustCompile(`(?i)^(?P<base>.+?)-(snapshot|\d{8}\.\d{6}-\d+)$`)
 )
 
 func candidateProductsForJava(p pkg.Package) []string {
-	return productsFromArtifactAndGroupIDs(artifactIDFromJavaPackage(p), GroupIDsFromJavaPackage(p))
+	return productsFromArtifactAndGroupIDs(artifactIDFromJavaPackage(p), groupIDCandidatesForJava(p))
 }
 
 func candidateVendorsForJava(p pkg.Package) fieldCandidateSet {
-	gidVendors := vendorsFromGroupIDs(GroupIDsFromJavaPackage(p))
+	gidVendors := vendorsFromGroupIDs(groupIDCandidatesForJava(p))
 	nameVendors := vendorsFromJavaManifestNames(p)
-	return newFieldCandidateSetFromSets(gidVendors, nameVendors)
+	vendors := newFieldCandidateSetFromSets(gidVendors, nameVendors)
+	if len(vendors) == 0 {
+		// the package itself has nothing to say about the vendor, so consider the archive it was nested within
+		vendors = vendorsFromJavaParent(p)
+	}
+	return vendors
+}
+
+// vendorsFromJavaParent returns the vendor candidates of the archive that the given package was found within (e.g. a
+// jar nested within a vendor-branded war). This is a weak signal, so no further variations are allowed to be made from
+// these candidates.
+func vendorsFromJavaParent(p pkg.Package) fieldCandidateSet {
+	vendors := newFieldCandidateSet()
+
+	metadata, ok := p.Metadata.(pkg.JavaMetadata)
+	if !ok || metadata.Parent == nil {
+		return vendors
+	}
+
+	for _, candidate := range candidateVendorsForJava(*metadata.Parent).list() {
+		candidate.disallowSubSelections = true
+		candidate.disallowDelimiterVariations = true
+		vendors.add(candidate)
+	}
+
+	return vendors
 }
 
 func vendorsFromJavaManifestNames(p pkg.Package) fieldCandidateSet {
@@ -96,6 +143,12 @@ func vendorsFromJavaManifestNames(p pkg.Package) fieldCandidateSet {
 func vendorsFromGroupIDs(groupIDs []string) fieldCandidateSet {
 	vendors := newFieldCandidateSet()
 	for _, groupID := range groupIDs {
+		if strings.HasPrefix(strings.ToLower(groupID), "org.apache.") {
+			// every project under the org.apache group is owned by the Apache Software Foundation, no matter how
+			// deep the group ID is (e.g. org.apache.logging.log4j)
+			vendors.addValue("apache")
+		}
+
 		for i, field := range strings.Split(groupID, ".") {
 			field = strings.TrimSpace(field)
 
@@ -187,6 +240,50 @@ func GroupIDsFromJavaPackage(p pkg.Package) (groupIDs []string) {
 	return groupIDs
 }
 
+// groupIDCandidatesForJava returns the group IDs of the package along with the group ID implied by the module name of
+// packages that do not have a pom.properties.
+func groupIDCandidatesForJava(p pkg.Package) []string {
+	groupIDs := appendMissing(GroupIDsFromJavaPackage(p), groupIDsFromAutomaticModuleName(p)...)
+	return appendMissing(groupIDs, groupIDsFromManifestVendorIDs(p)...)
+}
+
+// groupIDsFromManifestVendorIDs returns the reverse-DNS vendor IDs from the MANIFEST.MF fields that identify the vendor
+// for jars without a pom.properties (e.g. shaded jars), no matter the top-level domain (e.g. ch.qos.logback).
+func groupIDsFromManifestVendorIDs(p pkg.Package) (groupIDs []string) {
+	metadata, ok := p.Metadata.(pkg.JavaMetadata)
+	if !ok || metadata.PomProperties != nil || metadata.Manifest == nil {
+		return nil
+	}
+
+	for _, field := range javaManifestVendorIDFields {
+		value := cleanGroupID(metadata.Manifest.Main[field])
+		if !reverseDNSPattern.MatchString(value) {
+			continue
+		}
+		if javaPlatformNamespaces.Has(strings.Split(value, ".")[0]) {
+			continue
+		}
+		groupIDs = appendMissing(groupIDs, value)
+	}
+	return groupIDs
+}
+
+// groupIDsFromAutomaticModuleName returns the reverse-DNS module name from the MANIFEST.MF "Automatic-Module-Name" field
+// (e.g. org.apache.commons.io), which is the best remaining signal for jars without a pom.properties. Module names that
+// are a single token (e.g. commons.io or commonsio) say nothing about the group, so are not considered.
+func groupIDsFromAutomaticModuleName(p pkg.Package) []string {
+	metadata, ok := p.Metadata.(pkg.JavaMetadata)
+	if !ok || metadata.PomProperties != nil || metadata.Manifest == nil {
+		return nil
+	}
+
+	name := cleanGroupID(metadata.Manifest.Main["Automatic-Module-Name"])
+	if !startsWithTopLevelDomain(name) || len(strings.Split(name, ".")) < 2 {
+		return nil
+	}
+	return []string{name}
+}
+
 func groupIDsFromPomProperties(properties *pkg.PomProperties) (groupIDs []string) {
 	if properties == nil {
 		return nil
@@ -253,7 +350,83 @@ func groupIDsFromJavaManifest(manifest *pkg.JavaManifest) []string {
 	// for more info see pkg:maven/commons-io/commons-io@2.8.0 within cloudbees/cloudbees-core-mm:2.263.4.2
 	// at /usr/share/jenkins/jenkins.war:WEB-INF/plugins/analysis-model-api.hpi:WEB-INF/lib/commons-io-2.8.0.jar
 	// as well as the ant package from cloudbees/cloudbees-core-mm:2.277.2.4-ra.
-	return getManifestFieldGroupIDs(manifest, secondaryJavaManifestGroupIDFields)
+	groupIDs = getManifestFieldGroupIDs(manifest, secondaryJavaManifestGroupIDFields)
+
+	if len(groupIDs) != 0 {
+		return groupIDs
+	}
+
+	// large OSGi bundles may only describe themselves by the java packages they export, which is the weakest signal
+	// of all, so this is only considered when nothing else is available.
+	return groupIDsFromExportPackage(manifest)
+}
+
+// groupIDsFromExportPackage attempts to find a single group-id-like value from the OSGi "Export-Package" MANIFEST.MF
+// field by selecting the most common package prefix (e.g. "org.eclipse.jetty" from "org.eclipse.jetty.server,
+// org.eclipse.jetty.util, ..."). No value is returned unless a single prefix accounts for the majority of the exported
+// packages.
+func groupIDsFromExportPackage(manifest *pkg.JavaManifest) []string {
+	value, exists := manifest.Main["Export-Package"]
+	if !exists {
+		return nil
+	}
+
+	packageNames := exportedPackageNames(value)
+	if len(packageNames) == 0 {
+		return nil
+	}
+
+	prefixCounts := make(map[string]int)
+	for _, name := range packageNames {
+		fields := strings.Split(name, ".")
+		if len(fields) < 3 || !startsWithTopLevelDomain(name) {
+			continue
+		}
+		prefixCounts[strings.Join(fields[:3], ".")]++
+	}
+
+	var best string
+	var bestCount int
+	for prefix, count := range prefixCounts {
+		if count > bestCount || (count == bestCount && prefix < best) {
+			best, bestCount = prefix, count
+		}
+	}
+
+	if bestCount*2 <= len(packageNames) {
+		// there is no clear winner, don't guess
+		return nil
+	}
+
+	return []string{best}
+}
+
+// exportedPackageNames returns the package names from an OSGi "Export-Package" value, dropping any attributes or
+// directives (e.g. `org.foo;version="1.0";uses:="org.bar,org.baz",org.qux` -> [org.foo, org.qux]).
+func exportedPackageNames(value string) (names []string) {
+	var inQuotes bool
+	start := 0
+	for i := 0; i <= len(value); i++ {
+		if i < len(value) {
+			switch value[i] {
+			case '"':
+				inQuotes = !inQuotes
+				continue
+			case ',':
+				if inQuotes {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		name := strings.TrimSpace(strings.Split(value[start:i], ";")[0])
+		if name != "" {
+			names = append(names, name)
+		}
+		start = i + 1
+	}
+	return names
 }
 
 func getManifestFieldGroupIDs(manifest *pkg.JavaManifest, fields []string) (groupIDs []string) {
@@ -293,3 +466,52 @@ func removeOSCIDirectives(groupID string) string {
 func startsWithTopLevelDomain(value string) bool {
 	return internal.HasAnyOfPrefixes(value, domains...)
 }
+
+// stripJavaVariantClassifier removes any platform flavor classifier from the given version (e.g. 31.1-jre -> 31.1).
+func stripJavaVariantClassifier(version string) string {
+	for _, classifier := range javaVariantClassifiers {
+		if strings.HasSuffix(strings.ToLower(version), classifier) {
+			return version[:len(version)-len(classifier)]
+		}
+	}
+	return version
+}
+
+// stripJavaSnapshotQualifier returns the base version of a maven snapshot version (e.g. 1.0-20230101.120000-3 -> 1.0).
+func stripJavaSnapshotQualifier(version string) string {
+	if match := javaSnapshotVersionPattern.FindStringSubmatch(version); match != nil {
+		return match[javaSnapshotVersionPattern.SubexpIndex("base")]
+	}
+	return version
+}
+
+// relocateJavaPackage returns the package as described by the new coordinates of a relocated maven artifact, so CPEs
+// reflect the project the artifact moved to (e.g. mysql:mysql-connector-java -> com.mysql:mysql-connector-j). The
+// package is returned as-is if it has not been relocated.
+func relocateJavaPackage(p pkg.Package) pkg.Package {
+	metadata, ok := p.Metadata.(pkg.JavaMetadata)
+	if !ok || metadata.PomProject == nil || metadata.PomProject.Relocation == nil {
+		return p
+	}
+	relocation := metadata.PomProject.Relocation
+
+	// copy all coordinates before modifying them, since the metadata is shared with the original package
+	project := *metadata.PomProject
+	if relocation.GroupID != "" {
+		project.GroupID = relocation.GroupID
+	}
+	if relocation.ArtifactID != "" {
+		project.ArtifactID = relocation.ArtifactID
+		p.Name = relocation.ArtifactID
+	}
+	metadata.PomProject = &project
+
+	if metadata.PomProperties != nil {
+		properties := *metadata.PomProperties
+		properties.GroupID, properties.ArtifactID = project.GroupID, project.ArtifactID
+		metadata.PomProperties = &properties
+	}
+
+	p.Metadata = metadata
+	return p
+}
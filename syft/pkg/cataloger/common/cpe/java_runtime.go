@@ -0,0 +1,54 @@
+package cpe
+
+import (
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+)
+
+// javaRuntimeComponents are the name tokens that distros use to split a java runtime into several packages
+// (e.g. openjdk-17-jre-headless or java-11-openjdk-devel), none of which change the product the package belongs to.
+var javaRuntimeComponents = strset.New("jdk", "jre", "headless", "devel", "zero", "lts")
+
+// javaRuntimeVendorProducts returns the vendor/product pairs that NVD uses for a packaged java runtime, which are the
+// JDK or JRE (depending on which part of the runtime is packaged) and, for OpenJDK builds, openjdk itself
+// (e.g. openjdk-17-jre-headless -> [oracle:jre, oracle:openjdk]). Names that are not a java runtime return nil.
+func javaRuntimeVendorProducts(name string) []vendorProduct {
+	tokens := strings.Split(strings.ToLower(name), "-")
+
+	var openjdk bool
+	product := "jdk"
+	switch distribution := strings.TrimRight(tokens[0], "0123456789."); {
+	case distribution == "openjdk":
+		openjdk = true
+	case distribution == "java" && len(tokens) > 2 && isJavaRuntimeVersion(tokens[1]) && tokens[2] == "openjdk":
+		// e.g. java-11-openjdk
+		openjdk = true
+		tokens = tokens[2:]
+	case distribution == "jdk", distribution == "jre":
+		product = distribution
+	default:
+		return nil
+	}
+
+	for _, token := range tokens[1:] {
+		switch {
+		case token == "jre":
+			product = "jre"
+		case isJavaRuntimeVersion(token), javaRuntimeComponents.Has(token):
+		default:
+			// this is not a runtime, but something that is named after it (e.g. openjdk-11-doc or jre-tools)
+			return nil
+		}
+	}
+
+	pairs := []vendorProduct{{vendor: "oracle", product: product}}
+	if openjdk {
+		pairs = append(pairs, vendorProduct{vendor: "oracle", product: "openjdk"})
+	}
+	return pairs
+}
+
+func isJavaRuntimeVersion(token string) bool {
+	return token != "" && strings.Trim(token, "0123456789.") == ""
+}
@@ -0,0 +1,148 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_javaRuntimeVendorProducts(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []vendorProduct
+	}{
+		{
+			name: "openjdk-17-jre-headless",
+			expected: []vendorProduct{
+				{vendor: "oracle", product: "jre"},
+				{vendor: "oracle", product: "openjdk"},
+			},
+		},
+		{
+			name: "openjdk-11-jdk",
+			expected: []vendorProduct{
+				{vendor: "oracle", product: "jdk"},
+				{vendor: "oracle", product: "openjdk"},
+			},
+		},
+		{
+			name: "openjdk11",
+			expected: []vendorProduct{
+				{vendor: "oracle", product: "jdk"},
+				{vendor: "oracle", product: "openjdk"},
+			},
+		},
+		{
+			name: "openjdk8-jre",
+			expected: []vendorProduct{
+				{vendor: "oracle", product: "jre"},
+				{vendor: "oracle", product: "openjdk"},
+			},
+		},
+		{
+			name: "java-11-openjdk-devel",
+			expected: []vendorProduct{
+				{vendor: "oracle", product: "jdk"},
+				{vendor: "oracle", product: "openjdk"},
+			},
+		},
+		{
+			name: "java-1.8.0-openjdk-headless",
+			expected: []vendorProduct{
+				{vendor: "oracle", product: "jdk"},
+				{vendor: "oracle", product: "openjdk"},
+			},
+		},
+		{
+			name: "jdk-17",
+			expected: []vendorProduct{
+				{vendor: "oracle", product: "jdk"},
+			},
+		},
+		{
+			name: "jre",
+			expected: []vendorProduct{
+				{vendor: "oracle", product: "jre"},
+			},
+		},
+		{
+			name: "openjdk-11-doc",
+		},
+		{
+			name: "java-common",
+		},
+		{
+			name: "jdkmanager",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, javaRuntimeVendorProducts(test.name))
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_JavaRuntime(t *testing.T) {
+	tests := []struct {
+		name       string
+		p          pkg.Package
+		expected   []string
+		unexpected []string
+	}{
+		{
+			name: "openjdk jdk package",
+			p: pkg.Package{
+				Name:    "openjdk-17-jdk",
+				Version: "17.0.7",
+				Type:    pkg.DebPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:oracle:jdk:17.0.7:*:*:*:*:*:*:*",
+				"cpe:2.3:a:oracle:openjdk:17.0.7:*:*:*:*:*:*:*",
+			},
+			unexpected: []string{
+				"cpe:2.3:a:oracle:jre:17.0.7:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "openjdk jre package",
+			p: pkg.Package{
+				Name:    "java-11-openjdk-jre",
+				Version: "11.0.19",
+				Type:    pkg.RpmPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:oracle:jre:11.0.19:*:*:*:*:*:*:*",
+				"cpe:2.3:a:oracle:openjdk:11.0.19:*:*:*:*:*:*:*",
+			},
+			unexpected: []string{
+				"cpe:2.3:a:oracle:jdk:11.0.19:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "library jar is not a runtime",
+			p: pkg.Package{
+				Name:     "openjdk-17-jdk",
+				Version:  "17.0.7",
+				Language: pkg.Java,
+				Type:     pkg.JavaPkg,
+			},
+			unexpected: []string{
+				"cpe:2.3:a:oracle:jdk:17.0.7:*:*:*:*:*:*:*",
+				"cpe:2.3:a:oracle:openjdk:17.0.7:*:*:*:*:*:*:*",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := cpeStrings(Generate(test.p))
+			for _, c := range test.expected {
+				assert.Contains(t, actual, c)
+			}
+			for _, c := range test.unexpected {
+				assert.NotContains(t, actual, c)
+			}
+		})
+	}
+}
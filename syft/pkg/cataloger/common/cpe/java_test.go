@@ -96,6 +96,52 @@ func Test_candidateProductsForJava(t *testing.T) {
 			},
 			expected: []string{"nexus"},
 		},
+		{
+			name: "automatic module name without pom properties",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{
+					Manifest: &pkg.JavaManifest{
+						Main: map[string]string{
+							"Implementation-Vendor-Id": "org.apache",
+							"Automatic-Module-Name":    "org.apache.commons.io",
+						},
+					},
+				},
+			},
+			expected: []string{"commons", "io"},
+		},
+		{
+			name: "single token automatic module name",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{
+					Manifest: &pkg.JavaManifest{
+						Main: map[string]string{
+							"Implementation-Vendor-Id": "org.apache",
+							"Automatic-Module-Name":    "commonsio",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "automatic module name with pom properties",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{
+					PomProperties: &pkg.PomProperties{
+						GroupID:    "commons-io",
+						ArtifactID: "commons-io",
+					},
+					Manifest: &pkg.JavaManifest{
+						Main: map[string]string{
+							"Implementation-Vendor-Id": "org.apache",
+							"Automatic-Module-Name":    "org.apache.commons.io",
+						},
+					},
+				},
+			},
+			expected: []string{"commons-io"},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -105,6 +151,124 @@ func Test_candidateProductsForJava(t *testing.T) {
 	}
 }
 
+func Test_candidateVendorsForJava_automaticModuleName(t *testing.T) {
+	p := pkg.Package{
+		Metadata: pkg.JavaMetadata{
+			Manifest: &pkg.JavaManifest{
+				Main: map[string]string{
+					"Implementation-Vendor-Id": "org.apache",
+					"Automatic-Module-Name":    "org.apache.commons.io",
+				},
+			},
+		},
+	}
+
+	assert.ElementsMatch(t, []string{"apache", "commons", "io"}, candidateVendorsForJava(p).uniqueValues())
+}
+
+func Test_groupIDsFromManifestVendorIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata pkg.JavaMetadata
+		expected []string
+	}{
+		{
+			name: "implementation vendor id",
+			metadata: pkg.JavaMetadata{
+				Manifest: &pkg.JavaManifest{
+					Main: map[string]string{
+						"Implementation-Vendor-Id": "ch.qos.logback",
+					},
+				},
+			},
+			expected: []string{"ch.qos.logback"},
+		},
+		{
+			name: "bundle symbolic name with directives",
+			metadata: pkg.JavaMetadata{
+				Manifest: &pkg.JavaManifest{
+					Main: map[string]string{
+						"Bundle-SymbolicName": "de.mkammerer.argon2-jvm;singleton:=true",
+					},
+				},
+			},
+			expected: []string{"de.mkammerer.argon2-jvm"},
+		},
+		{
+			name: "both fields",
+			metadata: pkg.JavaMetadata{
+				Manifest: &pkg.JavaManifest{
+					Main: map[string]string{
+						"Implementation-Vendor-Id": "ch.qos.logback",
+						"Bundle-SymbolicName":      "ch.qos.logback.classic",
+					},
+				},
+			},
+			expected: []string{"ch.qos.logback", "ch.qos.logback.classic"},
+		},
+		{
+			name: "free-form values are not vendor IDs",
+			metadata: pkg.JavaMetadata{
+				Manifest: &pkg.JavaManifest{
+					Main: map[string]string{
+						"Implementation-Vendor-Id": "Oracle Corporation",
+						"Bundle-SymbolicName":      "logback",
+					},
+				},
+			},
+		},
+		{
+			name: "java platform namespaces",
+			metadata: pkg.JavaMetadata{
+				Manifest: &pkg.JavaManifest{
+					Main: map[string]string{
+						"Bundle-SymbolicName": "jakarta.servlet-api",
+					},
+				},
+			},
+		},
+		{
+			name: "pom properties take precedence",
+			metadata: pkg.JavaMetadata{
+				Manifest: &pkg.JavaManifest{
+					Main: map[string]string{
+						"Implementation-Vendor-Id": "ch.qos.logback",
+					},
+				},
+				PomProperties: &pkg.PomProperties{
+					GroupID:    "ch.qos.logback",
+					ArtifactID: "logback-classic",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, groupIDsFromManifestVendorIDs(pkg.Package{Metadata: test.metadata}))
+		})
+	}
+}
+
+func Test_candidateVendorsForJava_manifestVendorIDs(t *testing.T) {
+	p := pkg.Package{
+		Name:         "logback-classic",
+		Language:     pkg.Java,
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			Manifest: &pkg.JavaManifest{
+				Main: map[string]string{
+					"Implementation-Vendor":    "QOS.ch",
+					"Implementation-Vendor-Id": "ch.qos.logback",
+					"Bundle-SymbolicName":      "ch.qos.logback.classic",
+				},
+			},
+		},
+	}
+
+	assert.ElementsMatch(t, []string{"qos_ch", "qos", "logback", "classic"}, candidateVendorsForJava(p).uniqueValues())
+}
+
 func Test_vendorsFromGroupIDs(t *testing.T) {
 	tests := []struct {
 		groupID  string
@@ -134,6 +298,10 @@ func Test_vendorsFromGroupIDs(t *testing.T) {
 			groupID:  "com.google.guava",
 			expected: []string{"google", "guava"},
 		},
+		{
+			groupID:  "org.apache.logging.log4j",
+			expected: []string{"apache", "logging", "log4j"},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.groupID, func(t *testing.T) {
@@ -318,6 +486,46 @@ func Test_groupIDsFromJavaPackage(t *testing.T) {
 			},
 			expects: nil,
 		},
+		{
+			name: "from the most common Export-Package prefix",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{
+					Manifest: &pkg.JavaManifest{
+						Main: map[string]string{
+							"Export-Package": `org.eclipse.jetty.server;version="9.4.48";uses:="javax.servlet,org.eclipse.jetty.util",org.eclipse.jetty.server.handler;version="9.4.48",org.eclipse.jetty.util;version="9.4.48",javax.servlet.http`,
+						},
+					},
+				},
+			},
+			expects: []string{"org.eclipse.jetty"},
+		},
+		{
+			name: "from Export-Package without a clear winner",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{
+					Manifest: &pkg.JavaManifest{
+						Main: map[string]string{
+							"Export-Package": "org.eclipse.jetty.server,com.google.common.base,io.netty.buffer,org.slf4j",
+						},
+					},
+				},
+			},
+			expects: nil,
+		},
+		{
+			name: "Export-Package ignored when other manifest fields are present",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{
+					Manifest: &pkg.JavaManifest{
+						Main: map[string]string{
+							"Extension-Name": "io.jenkins-ci.plugin.thing",
+							"Export-Package": "org.eclipse.jetty.server,org.eclipse.jetty.util",
+						},
+					},
+				},
+			},
+			expects: []string{"io.jenkins-ci.plugin.thing"},
+		},
 		{
 			name: "no manifest or pom info",
 			pkg: pkg.Package{
@@ -426,3 +634,241 @@ func Test_vendorsFromJavaManifestNames(t *testing.T) {
 		})
 	}
 }
+
+func Test_candidateVendorsForJava_parentHint(t *testing.T) {
+	parent := &pkg.Package{
+		Name: "cloudbees-core",
+		Metadata: pkg.JavaMetadata{
+			PomProperties: &pkg.PomProperties{
+				GroupID: "com.cloudbees.core",
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		pkg     pkg.Package
+		expects []string
+	}{
+		{
+			name: "no parent",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{},
+			},
+			expects: nil,
+		},
+		{
+			name: "parent vendors used when the package has no vendor info",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{
+					Parent: parent,
+				},
+			},
+			expects: []string{"cloudbees", "core"},
+		},
+		{
+			name: "parent vendors ignored when the package has vendor info",
+			pkg: pkg.Package{
+				Metadata: pkg.JavaMetadata{
+					PomProperties: &pkg.PomProperties{
+						GroupID: "org.apache.commons",
+					},
+					Parent: parent,
+				},
+			},
+			expects: []string{"apache", "commons"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expects, candidateVendorsForJava(test.pkg).values())
+		})
+	}
+}
+
+func Test_stripJavaVariantClassifier(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{
+			version:  "31.1-jre",
+			expected: "31.1",
+		},
+		{
+			version:  "31.1-android",
+			expected: "31.1",
+		},
+		{
+			version:  "31.1",
+			expected: "31.1",
+		},
+		{
+			version:  "1.0.0-jre-beta",
+			expected: "1.0.0-jre-beta",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			assert.Equal(t, test.expected, stripJavaVariantClassifier(test.version))
+		})
+	}
+}
+
+func Test_stripJavaSnapshotQualifier(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{
+			version:  "1.0-20230101.120000-3",
+			expected: "1.0",
+		},
+		{
+			version:  "1.0-SNAPSHOT",
+			expected: "1.0",
+		},
+		{
+			version:  "2.3.1-snapshot",
+			expected: "2.3.1",
+		},
+		{
+			version:  "1.0",
+			expected: "1.0",
+		},
+		{
+			version:  "1.0-20230101",
+			expected: "1.0-20230101",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			assert.Equal(t, test.expected, stripJavaSnapshotQualifier(test.version))
+		})
+	}
+}
+
+func Test_relocateJavaPackage(t *testing.T) {
+	properties := &pkg.PomProperties{
+		GroupID:    "mysql",
+		ArtifactID: "mysql-connector-java",
+		Version:    "8.0.33",
+	}
+	original := pkg.Package{
+		Name:         "mysql-connector-java",
+		Version:      "8.0.33",
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			PomProperties: properties,
+			PomProject: &pkg.PomProject{
+				GroupID:    "mysql",
+				ArtifactID: "mysql-connector-java",
+				Version:    "8.0.33",
+				Relocation: &pkg.PomRelocation{
+					GroupID:    "com.mysql",
+					ArtifactID: "mysql-connector-j",
+				},
+			},
+		},
+	}
+
+	actual := relocateJavaPackage(original)
+	metadata := actual.Metadata.(pkg.JavaMetadata)
+	assert.Equal(t, "mysql-connector-j", actual.Name)
+	assert.Equal(t, "com.mysql", metadata.PomProperties.GroupID)
+	assert.Equal(t, "mysql-connector-j", metadata.PomProperties.ArtifactID)
+	assert.Equal(t, "com.mysql", metadata.PomProject.GroupID)
+	assert.Equal(t, "mysql-connector-j", metadata.PomProject.ArtifactID)
+
+	// the original package is not modified
+	assert.Equal(t, "mysql-connector-java", original.Name)
+	assert.Equal(t, "mysql", properties.GroupID)
+
+	notRelocated := pkg.Package{
+		Name:         "guava",
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			PomProject: &pkg.PomProject{GroupID: "com.google.guava", ArtifactID: "guava"},
+		},
+	}
+	assert.Equal(t, notRelocated, relocateJavaPackage(notRelocated))
+}
+
+func TestGeneratePackageCPEs_JavaVariantClassifier(t *testing.T) {
+	p := pkg.Package{
+		Name:         "guava",
+		Version:      "31.1-jre",
+		Language:     pkg.Java,
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			PomProperties: &pkg.PomProperties{
+				GroupID:    "com.google.guava",
+				ArtifactID: "guava",
+				Version:    "31.1-jre",
+			},
+		},
+	}
+
+	actual := cpeStrings(Generate(p))
+	assert.Contains(t, actual, "cpe:2.3:a:google:guava:31.1:*:*:*:*:*:*:*")
+	for _, c := range actual {
+		assert.NotContains(t, c, "jre")
+	}
+}
+
+func TestGeneratePackageCPEs_JavaSnapshotVersion(t *testing.T) {
+	p := pkg.Package{
+		Name:         "commons-text",
+		Version:      "1.10-20230101.120000-3",
+		Language:     pkg.Java,
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			PomProperties: &pkg.PomProperties{
+				GroupID:    "org.apache.commons",
+				ArtifactID: "commons-text",
+				Version:    "1.10-20230101.120000-3",
+			},
+		},
+	}
+
+	actual := cpeStrings(Generate(p))
+	assert.Contains(t, actual, "cpe:2.3:a:apache:commons-text:1.10:*:*:*:*:*:*:*")
+	for _, c := range actual {
+		assert.NotContains(t, c, "20230101")
+	}
+}
+
+func TestGeneratePackageCPEs_JavaRelocation(t *testing.T) {
+	p := pkg.Package{
+		Name:         "mysql-connector-java",
+		Version:      "8.0.33",
+		Language:     pkg.Java,
+		Type:         pkg.JavaPkg,
+		MetadataType: pkg.JavaMetadataType,
+		Metadata: pkg.JavaMetadata{
+			PomProperties: &pkg.PomProperties{
+				GroupID:    "mysql",
+				ArtifactID: "mysql-connector-java",
+				Version:    "8.0.33",
+			},
+			PomProject: &pkg.PomProject{
+				GroupID:    "mysql",
+				ArtifactID: "mysql-connector-java",
+				Version:    "8.0.33",
+				Relocation: &pkg.PomRelocation{
+					GroupID:    "com.mysql",
+					ArtifactID: "mysql-connector-j",
+				},
+			},
+		},
+	}
+
+	actual := cpeStrings(Generate(p))
+	assert.Contains(t, actual, "cpe:2.3:a:mysql:mysql-connector-j:8.0.33:*:*:*:*:*:*:*")
+	for _, c := range actual {
+		assert.NotContains(t, c, "mysql-connector-java")
+	}
+}
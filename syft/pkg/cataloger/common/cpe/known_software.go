@@ -0,0 +1,140 @@
+package cpe
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// vendorProduct is a single vendor and product pairing for a CPE.
+type vendorProduct struct {
+	vendor  string
+	product string
+}
+
+// knownSoftwareCPEs maps the names of well-known binaries to the exact vendor/product pairs that NVD uses for the
+// software they belong to (e.g. the apache2 binary is the Apache HTTP Server). Unlike defaultCandidateAdditions these
+// pairs are never mixed with other candidates, since the heuristics tend to do poorly with binary names.
+var knownSoftwareCPEs = map[string][]vendorProduct{
+	"apache2": {
+		{vendor: "apache", product: "http_server"},
+	},
+	"busybox": {
+		// a single binary that provides many applets (e.g. sh, ls, wget), all of which are the busybox project
+		{vendor: "busybox", product: "busybox"},
+	},
+	"curl": curlVendorProducts,
+	"httpd": {
+		{vendor: "apache", product: "http_server"},
+	},
+	"jetty": {
+		{vendor: "eclipse", product: "jetty"},
+	},
+	"libcurl": curlVendorProducts,
+	"nginx": {
+		{vendor: "nginx", product: "nginx"},
+		// newer vulnerabilities are listed under F5, which acquired nginx
+		{vendor: "f5", product: "nginx"},
+	},
+	"nginx-plus": {
+		// the commercial distribution is a separate product, which must not match vulnerabilities for nginx (open source)
+		{vendor: "nginx", product: "nginx_plus"},
+		{vendor: "f5", product: "nginx_plus"},
+	},
+	"node": {
+		{vendor: "nodejs", product: "node.js"},
+	},
+	"nodejs": {
+		{vendor: "nodejs", product: "node.js"},
+	},
+	"tomcat": {
+		{vendor: "apache", product: "tomcat"},
+	},
+
+	// databases
+	"mongod": {
+		{vendor: "mongodb", product: "mongodb"},
+	},
+	"mysqld": {
+		{vendor: "oracle", product: "mysql"},
+	},
+	"postgres": {
+		{vendor: "postgresql", product: "postgresql"},
+	},
+	"redis-server": {
+		{vendor: "redis", product: "redis"},
+	},
+
+	// shells
+	"bash": {
+		{vendor: "gnu", product: "bash"},
+	},
+	"fish": {
+		{vendor: "fishshell", product: "fish"},
+	},
+	"zsh": {
+		{vendor: "zsh", product: "zsh"},
+	},
+}
+
+// curlVendorProducts are the pairs for both the curl tool and the libcurl library, since NVD does not consistently
+// attribute vulnerabilities in the library to either name.
+var curlVendorProducts = []vendorProduct{
+	{vendor: "haxx", product: "curl"},
+	{vendor: "haxx", product: "libcurl"},
+	{vendor: "curl", product: "curl"},
+}
+
+// knownSoftwareIndex allows for finding entries in knownSoftwareCPEs by prefix (e.g. tomcat10 -> tomcat)
+var knownSoftwareIndex = newPrefixIndex(knownSoftwareNames()...)
+
+func knownSoftwareNames() (names []string) {
+	for name := range knownSoftwareCPEs {
+		names = append(names, name)
+	}
+	return names
+}
+
+// knownSoftwareVendorProducts returns the curated vendor/product pairs for the given package. There is no dedicated
+// package type for binaries, however, OS packages very commonly share the name of the primary binary they install,
+// so these package types are considered. Log4j (core) is considered for all java packages, see log4jVendorProducts.
+func knownSoftwareVendorProducts(p pkg.Package) []vendorProduct {
+	if pairs := log4jVendorProducts(p); pairs != nil {
+		return pairs
+	}
+
+	switch p.Type {
+	case pkg.ApkPkg, pkg.AlpmPkg, pkg.DebPkg, pkg.RpmPkg, pkg.PortagePkg:
+		if pairs := findKnownSoftware(p.Name); pairs != nil {
+			return pairs
+		}
+		if pairs := javaRuntimeVendorProducts(p.Name); pairs != nil {
+			return pairs
+		}
+		return phpVendorProducts(p.Name)
+	}
+	return nil
+}
+
+// findKnownSoftware returns the curated vendor/product pairs for the longest known name that matches the given name,
+// allowing for a version suffix, which is common for distros that package multiple major versions side by side
+// (e.g. tomcat10 or nodejs18).
+func findKnownSoftware(name string) []vendorProduct {
+	for _, known := range knownSoftwareIndex.prefixesOf(name) {
+		suffix := strings.TrimPrefix(name, known)
+		if strings.Trim(suffix, "0123456789.") == "" {
+			return knownSoftwareCPEs[known]
+		}
+	}
+	return nil
+}
+
+// curatedProducts returns the products that are known to describe the given package, either from the product
+// candidate additions or the well-known software pairs.
+func curatedProducts(p pkg.Package, cfg Config) []string {
+	products := findAdditionalProducts(cfg.candidateAdditions(), p.Type, p.Name)
+	for _, pair := range knownSoftwareVendorProducts(p) {
+		products = append(products, pair.product)
+	}
+	return products
+}
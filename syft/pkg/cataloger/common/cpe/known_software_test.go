@@ -0,0 +1,198 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_findKnownSoftware(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []vendorProduct
+	}{
+		{
+			name:     "tomcat",
+			expected: []vendorProduct{{vendor: "apache", product: "tomcat"}},
+		},
+		{
+			name:     "tomcat10",
+			expected: []vendorProduct{{vendor: "apache", product: "tomcat"}},
+		},
+		{
+			name:     "nodejs18",
+			expected: []vendorProduct{{vendor: "nodejs", product: "node.js"}},
+		},
+		{
+			name:     "redis-server6.2",
+			expected: []vendorProduct{{vendor: "redis", product: "redis"}},
+		},
+		{
+			name:     "postgres",
+			expected: []vendorProduct{{vendor: "postgresql", product: "postgresql"}},
+		},
+		{
+			name:     "mysqld",
+			expected: []vendorProduct{{vendor: "oracle", product: "mysql"}},
+		},
+		{
+			name:     "mongod",
+			expected: []vendorProduct{{vendor: "mongodb", product: "mongodb"}},
+		},
+		{
+			name:     "busybox",
+			expected: []vendorProduct{{vendor: "busybox", product: "busybox"}},
+		},
+		{
+			name:     "bash",
+			expected: []vendorProduct{{vendor: "gnu", product: "bash"}},
+		},
+		{
+			name:     "zsh",
+			expected: []vendorProduct{{vendor: "zsh", product: "zsh"}},
+		},
+		{
+			name:     "curl",
+			expected: curlVendorProducts,
+		},
+		{
+			name:     "libcurl4",
+			expected: curlVendorProducts,
+		},
+		{
+			name:     "nginx",
+			expected: []vendorProduct{{vendor: "nginx", product: "nginx"}, {vendor: "f5", product: "nginx"}},
+		},
+		{
+			name:     "nginx-plus",
+			expected: []vendorProduct{{vendor: "nginx", product: "nginx_plus"}, {vendor: "f5", product: "nginx_plus"}},
+		},
+		{
+			name:     "bash-completion",
+			expected: nil,
+		},
+		{
+			name:     "nginx-mod-http-geoip",
+			expected: nil,
+		},
+		{
+			name:     "postgresql-contrib",
+			expected: nil,
+		},
+		{
+			name:     "nodeenv",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, findKnownSoftware(test.name))
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_BusyBox(t *testing.T) {
+	p := pkg.Package{
+		Name:         "busybox",
+		Version:      "1.35.0",
+		Type:         pkg.ApkPkg,
+		MetadataType: pkg.ApkMetadataType,
+		Metadata: pkg.ApkMetadata{
+			Package:       "busybox",
+			OriginPackage: "busybox",
+		},
+	}
+
+	assert.Contains(t, cpeStrings(Generate(p)), "cpe:2.3:a:busybox:busybox:1.35.0:*:*:*:*:*:*:*")
+}
+
+func TestGeneratePackageCPEs_Shells(t *testing.T) {
+	bash := pkg.Package{
+		Name:    "bash",
+		Version: "5.1",
+		Type:    pkg.DebPkg,
+	}
+	assert.Contains(t, cpeStrings(Generate(bash)), "cpe:2.3:a:gnu:bash:5.1:*:*:*:*:*:*:*")
+
+	zsh := pkg.Package{
+		Name:    "zsh",
+		Version: "5.8",
+		Type:    pkg.DebPkg,
+	}
+	assert.Contains(t, cpeStrings(Generate(zsh)), "cpe:2.3:a:zsh:zsh:5.8:*:*:*:*:*:*:*")
+}
+
+func TestGeneratePackageCPEs_Curl(t *testing.T) {
+	expected := []string{
+		"cpe:2.3:a:haxx:curl:7.74.0:*:*:*:*:*:*:*",
+		"cpe:2.3:a:haxx:libcurl:7.74.0:*:*:*:*:*:*:*",
+		"cpe:2.3:a:curl:curl:7.74.0:*:*:*:*:*:*:*",
+	}
+
+	for _, name := range []string{"curl", "libcurl"} {
+		t.Run(name, func(t *testing.T) {
+			actual := cpeStrings(Generate(pkg.Package{
+				Name:    name,
+				Version: "7.74.0",
+				Type:    pkg.DebPkg,
+			}))
+			assert.Subset(t, actual, expected)
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_Nginx(t *testing.T) {
+	actual := cpeStrings(Generate(pkg.Package{
+		Name:    "nginx",
+		Version: "1.22.1",
+		Type:    pkg.ApkPkg,
+	}))
+	assert.Contains(t, actual, "cpe:2.3:a:nginx:nginx:1.22.1:*:*:*:*:*:*:*")
+	assert.Contains(t, actual, "cpe:2.3:a:f5:nginx:1.22.1:*:*:*:*:*:*:*")
+
+	actual = cpeStrings(Generate(pkg.Package{
+		Name:    "nginx-plus",
+		Version: "27",
+		Type:    pkg.DebPkg,
+	}))
+	assert.Contains(t, actual, "cpe:2.3:a:nginx:nginx_plus:27:*:*:*:*:*:*:*")
+	assert.Contains(t, actual, "cpe:2.3:a:f5:nginx_plus:27:*:*:*:*:*:*:*")
+	for _, c := range actual {
+		assert.NotContains(t, c, ":nginx:27:", "nginx plus must not be described as nginx (open source)")
+	}
+}
+
+func TestGeneratePackageCPEs_Databases(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "postgres",
+			expected: "cpe:2.3:a:postgresql:postgresql:15.2:*:*:*:*:*:*:*",
+		},
+		{
+			name:     "mysqld",
+			expected: "cpe:2.3:a:oracle:mysql:15.2:*:*:*:*:*:*:*",
+		},
+		{
+			name:     "mongod",
+			expected: "cpe:2.3:a:mongodb:mongodb:15.2:*:*:*:*:*:*:*",
+		},
+		{
+			name:     "redis-server",
+			expected: "cpe:2.3:a:redis:redis:15.2:*:*:*:*:*:*:*",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := cpeStrings(Generate(pkg.Package{
+				Name:    test.name,
+				Version: "15.2",
+				Type:    pkg.ApkPkg,
+			}))
+			assert.Contains(t, actual, test.expected)
+		})
+	}
+}
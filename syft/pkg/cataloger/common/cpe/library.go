@@ -0,0 +1,48 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+)
+
+// libraryAPIVersionPattern matches OS library package names that carry the API (soname) version of the library
+// (e.g. libapr1 or libapr-1).
+var libraryAPIVersionPattern = regexp.MustCompile(`^lib(?P<name>[a-z][a-z0-9+]*?)-?[0-9]+(\.[0-9]+)*$`)
+
+// candidateProductsForLibrary returns the names of the library without the API version marker, both with and without
+// the "lib" prefix (e.g. libapr-1 -> [libapr, apr]). Very short names are not considered since they tend to be too
+// generic to describe a single project (e.g. libc6 -> c).
+func candidateProductsForLibrary(name string) []string {
+	match := libraryAPIVersionPattern.FindStringSubmatch(name)
+	if match == nil {
+		return nil
+	}
+
+	library := match[libraryAPIVersionPattern.SubexpIndex("name")]
+	if len(library) < 3 {
+		return nil
+	}
+
+	return []string{"lib" + library, library}
+}
+
+// candidateProductsForCLibrary returns the "lib" sibling of the given C/C++ library name, since NVD may list a library
+// either with or without the prefix (e.g. libpng <-> png). Names where the stem is too short to stand on its own are
+// paired by moving the "lib" affix instead (e.g. zlib <-> libz).
+func candidateProductsForCLibrary(name string) []string {
+	if stem := strings.TrimPrefix(name, "lib"); stem != name {
+		switch {
+		case len(stem) >= 3:
+			return []string{stem}
+		case stem != "":
+			return []string{stem + "lib"}
+		}
+		return nil
+	}
+
+	candidates := []string{"lib" + name}
+	if stem := strings.TrimSuffix(name, "lib"); stem != name && stem != "" {
+		candidates = append(candidates, "lib"+stem)
+	}
+	return candidates
+}
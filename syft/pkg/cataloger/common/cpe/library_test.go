@@ -0,0 +1,77 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_candidateProductsForLibrary(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{
+			name:     "libapr-1",
+			expected: []string{"libapr", "apr"},
+		},
+		{
+			name:     "libapr1",
+			expected: []string{"libapr", "apr"},
+		},
+		{
+			name:     "libssl1.1",
+			expected: []string{"libssl", "ssl"},
+		},
+		{
+			name:     "libc6",
+			expected: nil,
+		},
+		{
+			name:     "libapr",
+			expected: nil,
+		},
+		{
+			name:     "apr",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, candidateProductsForLibrary(test.name))
+		})
+	}
+}
+
+func Test_candidateProductsForCLibrary(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{
+			name:     "libpng",
+			expected: []string{"png"},
+		},
+		{
+			name:     "png",
+			expected: []string{"libpng"},
+		},
+		{
+			name:     "zlib",
+			expected: []string{"libzlib", "libz"},
+		},
+		{
+			name:     "libz",
+			expected: []string{"zlib"},
+		},
+		{
+			name:     "lib",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, candidateProductsForCLibrary(test.name))
+		})
+	}
+}
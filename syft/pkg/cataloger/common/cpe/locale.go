@@ -0,0 +1,62 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+)
+
+// localeLanguages maps the language and country codes that packages commonly append to their name for localized builds
+// to the language tag (RFC 5646) that the code indicates. Country codes are mapped to the primary language of the country.
+var localeLanguages = map[string]string{
+	// languages
+	"cs": "cs",
+	"de": "de",
+	"en": "en",
+	"es": "es",
+	"fr": "fr",
+	"it": "it",
+	"ja": "ja",
+	"ko": "ko",
+	"nl": "nl",
+	"pl": "pl",
+	"pt": "pt",
+	"ru": "ru",
+	"sv": "sv",
+	"uk": "uk",
+	"zh": "zh",
+
+	// countries
+	"br": "pt-br",
+	"cn": "zh-cn",
+	"gb": "en-gb",
+	"jp": "ja",
+	"kr": "ko",
+	"tw": "zh-tw",
+	"us": "en-us",
+}
+
+// localeSuffixPattern matches a trailing 2-letter code after a separator (e.g. calendar-en or foo_us)
+var localeSuffixPattern = regexp.MustCompile(`^(?P<name>.+?)[-_](?P<code>[a-zA-Z]{2})$`)
+
+// stripLocaleSuffix removes any recognized locale suffix from the given name (e.g. calendar-en -> calendar). An empty
+// string is returned if the name does not have a locale suffix.
+func stripLocaleSuffix(name string) string {
+	match := localeSuffixPattern.FindStringSubmatch(name)
+	if match == nil {
+		return ""
+	}
+	if _, ok := localeLanguages[strings.ToLower(match[localeSuffixPattern.SubexpIndex("code")])]; !ok {
+		return ""
+	}
+	return match[localeSuffixPattern.SubexpIndex("name")]
+}
+
+// localeLanguage returns the language tag for any recognized locale suffix of the given name (e.g. calendar-en -> en).
+// An empty string is returned if the name does not have a locale suffix.
+func localeLanguage(name string) string {
+	match := localeSuffixPattern.FindStringSubmatch(name)
+	if match == nil {
+		return ""
+	}
+	return localeLanguages[strings.ToLower(match[localeSuffixPattern.SubexpIndex("code")])]
+}
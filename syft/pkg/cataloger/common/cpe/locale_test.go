@@ -0,0 +1,105 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_stripLocaleSuffix(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "calendar-en",
+			expected: "calendar",
+		},
+		{
+			name:     "foo_US",
+			expected: "foo",
+		},
+		{
+			name:     "foo-bar-de",
+			expected: "foo-bar",
+		},
+		{
+			name:     "python-qt",
+			expected: "",
+		},
+		{
+			name:     "en",
+			expected: "",
+		},
+		{
+			name:     "calendar",
+			expected: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, stripLocaleSuffix(test.name))
+		})
+	}
+}
+
+func Test_localeLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "calendar-en",
+			expected: "en",
+		},
+		{
+			name:     "foo_US",
+			expected: "en-us",
+		},
+		{
+			name:     "firefox-l10n-pt_br",
+			expected: "pt-br",
+		},
+		{
+			name:     "python-qt",
+			expected: "",
+		},
+		{
+			name:     "calendar",
+			expected: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, localeLanguage(test.name))
+		})
+	}
+}
+
+func TestGenerateWithConfig_StripLocaleSuffixes(t *testing.T) {
+	p := pkg.Package{
+		Name:    "calendar-en",
+		Version: "1.0",
+		Type:    pkg.DebPkg,
+	}
+
+	assert.NotContains(t, cpeStrings(GenerateWithConfig(p, Config{})), "cpe:2.3:a:calendar:calendar:1.0:*:*:*:*:*:*:*")
+	assert.Contains(t, cpeStrings(GenerateWithConfig(p, Config{StripLocaleSuffixes: true})), "cpe:2.3:a:calendar:calendar:1.0:*:*:*:*:*:*:*")
+}
+
+func TestGenerateWithConfig_IncludeLanguage(t *testing.T) {
+	p := pkg.Package{
+		Name:    "calendar-en",
+		Version: "1.0",
+		Type:    pkg.DebPkg,
+	}
+
+	localized := "cpe:2.3:a:calendar-en:calendar-en:1.0:*:*:en:*:*:*:*"
+
+	assert.NotContains(t, cpeStrings(GenerateWithConfig(p, Config{})), localized)
+
+	actual := cpeStrings(GenerateWithConfig(p, Config{IncludeLanguage: true}))
+	assert.Contains(t, actual, localized)
+	assert.Contains(t, actual, "cpe:2.3:a:calendar-en:calendar-en:1.0:*:*:*:*:*:*:*")
+}
@@ -0,0 +1,62 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// log4jGroupIDs are the maven group IDs that log4j has been published under (1.x and 2.x respectively)
+var log4jGroupIDs = []string{"log4j", "org.apache.logging.log4j"}
+
+// log4jCoreNames are the forms the name of a log4j (core) package may arrive in: the artifact ID, the java module name,
+// or the group ID qualified artifact ID.
+var log4jCoreNames = []string{
+	"log4j",
+	"log4j-core",
+	"org.apache.logging.log4j.core",
+	"org.apache.logging.log4j:log4j-core",
+	"log4j:log4j",
+}
+
+// log4jJarSuffixPattern matches the version and extension of a jar file name (e.g. log4j-core-2.14.1.jar)
+var log4jJarSuffixPattern = regexp.MustCompile(`(-\d[\w.-]*)?\.jar$`)
+
+// log4jVendorProducts returns the vendor/product pair that NVD lists all log4j (core) vulnerabilities under
+// (e.g. log4shell), regardless of whether the package is named by the artifact ID, group ID, or jar file name. Since
+// the impact of a missed match is so high this does not rely on the heuristics producing the pair.
+func log4jVendorProducts(p pkg.Package) []vendorProduct {
+	if !isLog4jCore(p) {
+		return nil
+	}
+	return []vendorProduct{{vendor: "apache", product: "log4j"}}
+}
+
+func isLog4jCore(p pkg.Package) bool {
+	if p.Type != pkg.JavaPkg && p.Language != pkg.Java && p.MetadataType != pkg.JavaMetadataType {
+		return false
+	}
+
+	name := log4jJarSuffixPattern.ReplaceAllString(strings.ToLower(p.Name), "")
+	for _, n := range log4jCoreNames {
+		if name == n {
+			return true
+		}
+	}
+
+	metadata, ok := p.Metadata.(pkg.JavaMetadata)
+	if !ok || metadata.PomProperties == nil {
+		return false
+	}
+	artifactID := strings.ToLower(metadata.PomProperties.ArtifactID)
+	if artifactID != "log4j" && artifactID != "log4j-core" {
+		return false
+	}
+	for _, groupID := range log4jGroupIDs {
+		if strings.EqualFold(metadata.PomProperties.GroupID, groupID) {
+			return true
+		}
+	}
+	return false
+}
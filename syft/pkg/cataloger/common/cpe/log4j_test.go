@@ -0,0 +1,118 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isLog4jCore(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected bool
+	}{
+		{
+			name:     "artifact ID",
+			p:        pkg.Package{Name: "log4j-core", Type: pkg.JavaPkg},
+			expected: true,
+		},
+		{
+			name:     "log4j 1.x",
+			p:        pkg.Package{Name: "log4j", Type: pkg.JavaPkg},
+			expected: true,
+		},
+		{
+			name:     "jar file name",
+			p:        pkg.Package{Name: "log4j-core-2.14.1.jar", Type: pkg.JavaPkg},
+			expected: true,
+		},
+		{
+			name:     "java module name",
+			p:        pkg.Package{Name: "org.apache.logging.log4j.core", Language: pkg.Java},
+			expected: true,
+		},
+		{
+			name: "group ID",
+			p: pkg.Package{
+				Name:         "core",
+				Type:         pkg.JavaPkg,
+				MetadataType: pkg.JavaMetadataType,
+				Metadata: pkg.JavaMetadata{
+					PomProperties: &pkg.PomProperties{
+						GroupID:    "org.apache.logging.log4j",
+						ArtifactID: "log4j-core",
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "log4j api",
+			p: pkg.Package{
+				Name:         "log4j-api",
+				Type:         pkg.JavaPkg,
+				MetadataType: pkg.JavaMetadataType,
+				Metadata: pkg.JavaMetadata{
+					PomProperties: &pkg.PomProperties{
+						GroupID:    "org.apache.logging.log4j",
+						ArtifactID: "log4j-api",
+					},
+				},
+			},
+		},
+		{
+			name: "log4j bridge",
+			p:    pkg.Package{Name: "log4j-slf4j-impl-2.14.1.jar", Type: pkg.JavaPkg},
+		},
+		{
+			name: "not a java package",
+			p:    pkg.Package{Name: "log4j", Type: pkg.NpmPkg},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isLog4jCore(test.p))
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_Log4j(t *testing.T) {
+	tests := []struct {
+		name string
+		p    pkg.Package
+	}{
+		{
+			name: "jar file name",
+			p: pkg.Package{
+				Name:     "log4j-core-2.14.1.jar",
+				Version:  "2.14.1",
+				Language: pkg.Java,
+				Type:     pkg.JavaPkg,
+			},
+		},
+		{
+			name: "group ID",
+			p: pkg.Package{
+				Name:         "log4j-core",
+				Version:      "2.14.1",
+				Language:     pkg.Java,
+				Type:         pkg.JavaPkg,
+				MetadataType: pkg.JavaMetadataType,
+				Metadata: pkg.JavaMetadata{
+					PomProperties: &pkg.PomProperties{
+						GroupID:    "org.apache.logging.log4j",
+						ArtifactID: "log4j-core",
+						Version:    "2.14.1",
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Contains(t, cpeStrings(Generate(test.p)), "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*")
+		})
+	}
+}
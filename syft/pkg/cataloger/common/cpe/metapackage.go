@@ -0,0 +1,28 @@
+package cpe
+
+import (
+	"regexp"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// metapackageDescriptionPattern matches the phrases that distros use to describe packages that only pull in other
+// packages (e.g. "This is a metapackage", "Meta package for build base", or "transitional dummy package").
+var metapackageDescriptionPattern = regexp.MustCompile(`(?i)\b(meta[- ]?package|dummy package|transitional package)\b`)
+
+// isMetapackage indicates if the given package is a distro metapackage, which installs no code of its own, so any
+// CPE for it would imply a vulnerable component that is not really there.
+func isMetapackage(p pkg.Package) bool {
+	var description string
+	switch metadata := p.Metadata.(type) {
+	case pkg.DpkgMetadata:
+		description = metadata.Description
+	case pkg.ApkMetadata:
+		description = metadata.Description
+	case pkg.AlpmMetadata:
+		description = metadata.Description
+	default:
+		return false
+	}
+	return metapackageDescriptionPattern.MatchString(description)
+}
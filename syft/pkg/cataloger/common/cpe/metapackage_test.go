@@ -0,0 +1,86 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isMetapackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected bool
+	}{
+		{
+			name: "debian metapackage",
+			p: pkg.Package{
+				Name: "ubuntu-minimal",
+				Metadata: pkg.DpkgMetadata{
+					Description: "Minimal core of Ubuntu\n This package depends on all of the packages in the Ubuntu minimal system.\n It is also used to help ensure proper upgrades, so it is recommended that\n it not be removed. This is a metapackage.",
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "debian transitional package",
+			p: pkg.Package{
+				Name: "libgcc1",
+				Metadata: pkg.DpkgMetadata{
+					Description: "GCC support library (transitional dummy package)",
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "alpine meta package",
+			p: pkg.Package{
+				Name: "alpine-base",
+				Metadata: pkg.ApkMetadata{
+					Description: "Meta package for minimal alpine base",
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "regular debian package",
+			p: pkg.Package{
+				Name: "libssl1.1",
+				Metadata: pkg.DpkgMetadata{
+					Description: "Secure Sockets Layer toolkit - shared libraries",
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "metadata without descriptions is never a metapackage",
+			p: pkg.Package{
+				Name:     "metapackage",
+				Metadata: pkg.GemMetadata{},
+			},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isMetapackage(test.p))
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_Metapackage(t *testing.T) {
+	p := pkg.Package{
+		Name:         "build-base",
+		Version:      "0.5-r3",
+		Type:         pkg.ApkPkg,
+		MetadataType: pkg.ApkMetadataType,
+		Metadata: pkg.ApkMetadata{
+			Package:     "build-base",
+			Description: "Meta package for build base",
+		},
+	}
+
+	assert.NotEmpty(t, Generate(pkg.Package{Name: p.Name, Version: p.Version, Type: p.Type}))
+	assert.Empty(t, Generate(p))
+}
@@ -0,0 +1,10 @@
+package cpe
+
+// Metrics describes the work done to generate the CPEs for a single package, which is reported to Config.Observer.
+type Metrics struct {
+	Vendors        int // the number of vendor candidates
+	Products       int // the number of product candidates
+	TargetSoftware int // the number of target software candidates (including the "any" value)
+	CandidateCPEs  int // the number of unique CPEs before known false positives were filtered out
+	CPEs           int // the number of CPEs that remain after filtering (before any Config.PostProcess function)
+}
@@ -0,0 +1,46 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// distTagPattern matches names that accidentally include an npm dist-tag (e.g. react@next or @angular/core@latest). Tags
+// with digits are not matched, since these are more likely versions or version ranges than tags.
+var distTagPattern = regexp.MustCompile(`^(?P<name>[^@]*@?[^@]+)@[a-zA-Z][a-zA-Z._-]*$`)
+
+// splitNpmScope returns the scope and the unscoped name of a scoped npm package (e.g. @angular/core -> angular, core).
+// Empty strings are returned for packages that are not npm packages or are not scoped.
+func splitNpmScope(p pkg.Package) (scope, name string) {
+	if p.Type != pkg.NpmPkg || !strings.HasPrefix(p.Name, "@") {
+		return "", ""
+	}
+	fields := strings.SplitN(strings.TrimPrefix(p.Name, "@"), "/", 2)
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		return "", ""
+	}
+	return fields[0], fields[1]
+}
+
+// candidateProductsForNpm returns the product candidates for a scoped npm package, which NVD lists under the unscoped
+// name, the scope (typically the project), or both joined together (e.g. @angular/core -> [core, angular, angular_core]).
+func candidateProductsForNpm(p pkg.Package) []string {
+	scope, name := splitNpmScope(p)
+	if scope == "" {
+		return nil
+	}
+	return []string{name, scope, scope + "_" + name}
+}
+
+// stripDistTag returns the package without any dist-tag in the name (e.g. react@next -> react). The package is returned
+// as-is if the name does not have a dist-tag.
+func stripDistTag(p pkg.Package) pkg.Package {
+	match := distTagPattern.FindStringSubmatch(p.Name)
+	if match == nil {
+		return p
+	}
+	p.Name = match[distTagPattern.SubexpIndex("name")]
+	return p
+}
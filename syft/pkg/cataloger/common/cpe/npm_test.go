@@ -0,0 +1,159 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/facebookincubator/nvdtools/wfn"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_candidateProductsForNpm(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "scoped package",
+			p: pkg.Package{
+				Name: "@angular/core",
+				Type: pkg.NpmPkg,
+			},
+			expected: []string{"core", "angular", "angular_core"},
+		},
+		{
+			name: "unscoped package",
+			p: pkg.Package{
+				Name: "lodash",
+				Type: pkg.NpmPkg,
+			},
+		},
+		{
+			name: "incomplete scope",
+			p: pkg.Package{
+				Name: "@angular",
+				Type: pkg.NpmPkg,
+			},
+		},
+		{
+			name: "not an npm package",
+			p: pkg.Package{
+				Name: "@angular/core",
+				Type: pkg.GemPkg,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, candidateProductsForNpm(test.p))
+		})
+	}
+}
+
+func Test_stripDistTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "react@next",
+			expected: "react",
+		},
+		{
+			name:     "@angular/core@latest",
+			expected: "@angular/core",
+		},
+		{
+			name:     "react@18.2.0",
+			expected: "react@18.2.0",
+		},
+		{
+			name:     "react@v18",
+			expected: "react@v18",
+		},
+		{
+			name:     "@angular/core",
+			expected: "@angular/core",
+		},
+		{
+			name:     "react",
+			expected: "react",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, stripDistTag(pkg.Package{Name: test.name}).Name)
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_DistTag(t *testing.T) {
+	tests := []struct {
+		name            string
+		p               pkg.Package
+		expectedProduct string
+	}{
+		{
+			name: "npm package with dist-tag",
+			p: pkg.Package{
+				Name:     "react@next",
+				Version:  "18.3.0",
+				Language: pkg.JavaScript,
+				Type:     pkg.NpmPkg,
+			},
+			expectedProduct: "react",
+		},
+		{
+			name: "non-npm package name is unchanged",
+			p: pkg.Package{
+				Name:     "widget@next",
+				Version:  "1.0.0",
+				Language: pkg.Ruby,
+				Type:     pkg.GemPkg,
+			},
+			expectedProduct: "widget@next",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cpes := Generate(test.p)
+			assert.NotEmpty(t, cpes)
+			for _, c := range cpes {
+				assert.Equal(t, test.expectedProduct, c.Product)
+			}
+		})
+	}
+}
+
+func TestCandidateVendor_NpmScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "scoped package",
+			p: pkg.Package{
+				Name:     "@babel/parser",
+				Language: pkg.JavaScript,
+				Type:     pkg.NpmPkg,
+			},
+			expected: []string{"babel", wfn.Any},
+		},
+		{
+			name: "unscoped package",
+			p: pkg.Package{
+				Name:     "lodash",
+				Language: pkg.JavaScript,
+				Type:     pkg.NpmPkg,
+			},
+			expected: []string{wfn.Any},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, candidateVendors(test.p, Config{ExcludeProductVendors: true}))
+		})
+	}
+}
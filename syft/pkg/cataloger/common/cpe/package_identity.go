@@ -0,0 +1,95 @@
+package cpe
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/facebookincubator/nvdtools/wfn"
+)
+
+// FromPackageIdentity creates application CPEs from the project identity that another SBOM describes a package with:
+// the package URL (the namespace as vendor and the name as product) and the project website (the repository owner
+// and name for well-known forges). This is useful for imported packages, which do not carry enough metadata for
+// Generate to find good candidates. Nil is returned if no identity can be found.
+func FromPackageIdentity(purl, website, version string) []pkg.CPE {
+	var candidates []vendorProduct
+	if p, err := packageurl.FromString(purl); err == nil {
+		if version == "" {
+			version = p.Version
+		}
+		candidates = append(candidates, vendorProductsFromPURL(p)...)
+	}
+
+	if owner, name := sourceRepoFromURL(website); owner != "" {
+		candidates = append(candidates, vendorProduct{vendor: owner, product: name})
+	}
+
+	if version == "" {
+		return nil
+	}
+
+	var cpes []pkg.CPE
+	seen := make(map[vendorProduct]struct{})
+	for _, candidate := range candidates {
+		candidate.vendor, candidate.product = strings.ToLower(candidate.vendor), strings.ToLower(candidate.product)
+		if _, ok := seen[candidate]; ok {
+			continue
+		}
+		seen[candidate] = struct{}{}
+		if cpe := newCPE(candidate.product, candidate.vendor, strings.ToLower(version), wfn.Any, wfn.Any); cpe != nil {
+			cpes = append(cpes, *cpe)
+		}
+	}
+	return cpes
+}
+
+// vendorProductsFromPURL returns the vendor and product pairs described by the namespace and name of a package URL
+// (e.g. pkg:github/psf/requests -> psf:requests, or pkg:maven/org.apache.commons/commons-text -> apache:commons-text).
+func vendorProductsFromPURL(p packageurl.PackageURL) (candidates []vendorProduct) {
+	if p.Name == "" || p.Namespace == "" {
+		return nil
+	}
+
+	var vendors []string
+	switch p.Type {
+	case packageurl.TypeMaven:
+		vendors = vendorsFromGroupIDs([]string{p.Namespace}).uniqueValues()
+	case packageurl.TypeGolang:
+		// the namespace and name together are the module path (e.g. pkg:golang/github.com/spf13/cobra)
+		path := p.Namespace + "/" + p.Name
+		vendor, product := candidateVendorForGo(path), candidateProductForGo(path)
+		if vendor == "" || product == "" {
+			return nil
+		}
+		return []vendorProduct{{vendor: vendor, product: product}}
+	case packageurl.TypeGithub, packageurl.TypeBitbucket, packageurl.TypeNPM, packageurl.TypeComposer:
+		// the namespace is the owner of the project (for npm this is the scope, e.g. @angular)
+		fields := strings.Split(p.Namespace, "/")
+		vendors = []string{strings.TrimPrefix(fields[len(fields)-1], "@")}
+	default:
+		// the namespace of all other package URL types is a distro or registry, which says nothing about the vendor
+		return nil
+	}
+
+	for _, vendor := range vendors {
+		candidates = append(candidates, vendorProduct{vendor: vendor, product: p.Name})
+	}
+	return candidates
+}
+
+// candidatesFromPURL returns the vendor and product candidates described by the given package URL, if any.
+func candidatesFromPURL(purl string) (vendors, products []string) {
+	if purl == "" {
+		return nil, nil
+	}
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return nil, nil
+	}
+	for _, candidate := range vendorProductsFromPURL(p) {
+		vendors = append(vendors, candidate.vendor)
+		products = append(products, candidate.product)
+	}
+	return vendors, products
+}
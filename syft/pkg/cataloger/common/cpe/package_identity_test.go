@@ -0,0 +1,122 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/scylladb/go-set/strset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromPackageIdentity(t *testing.T) {
+	tests := []struct {
+		name     string
+		purl     string
+		website  string
+		version  string
+		expected []string
+	}{
+		{
+			name:     "github purl",
+			purl:     "pkg:github/psf/requests@2.28.1",
+			expected: []string{"cpe:2.3:a:psf:requests:2.28.1:*:*:*:*:*:*:*"},
+		},
+		{
+			name:     "maven purl",
+			purl:     "pkg:maven/org.apache.commons/commons-text@1.9",
+			expected: []string{"cpe:2.3:a:apache:commons-text:1.9:*:*:*:*:*:*:*", "cpe:2.3:a:commons:commons-text:1.9:*:*:*:*:*:*:*"},
+		},
+		{
+			name:     "scoped npm purl",
+			purl:     "pkg:npm/%40angular/core@14.2.0",
+			expected: []string{"cpe:2.3:a:angular:core:14.2.0:*:*:*:*:*:*:*"},
+		},
+		{
+			name:     "golang purl",
+			purl:     "pkg:golang/github.com/spf13/cobra@v1.6.1",
+			version:  "1.6.1",
+			expected: []string{"cpe:2.3:a:spf13:cobra:1.6.1:*:*:*:*:*:*:*"},
+		},
+		{
+			name:     "distro purl says nothing about the vendor",
+			purl:     "pkg:deb/debian/curl@7.74.0",
+			expected: nil,
+		},
+		{
+			name:     "website",
+			website:  "https://github.com/curl/curl",
+			version:  "7.74.0",
+			expected: []string{"cpe:2.3:a:curl:curl:7.74.0:*:*:*:*:*:*:*"},
+		},
+		{
+			name:     "purl and website describe the same project",
+			purl:     "pkg:github/psf/requests@2.28.1",
+			website:  "https://github.com/psf/requests",
+			expected: []string{"cpe:2.3:a:psf:requests:2.28.1:*:*:*:*:*:*:*"},
+		},
+		{
+			name:     "no version",
+			website:  "https://github.com/curl/curl",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, cpeStrings(FromPackageIdentity(test.purl, test.website, test.version)))
+		})
+	}
+}
+
+func TestGenerateWithConfig_IncludePURLCandidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "golang",
+			p: pkg.Package{
+				Name:     "github.com/spf13/cobra",
+				Version:  "v1.6.1",
+				Language: pkg.Go,
+				Type:     pkg.GoModulePkg,
+				PURL:     "pkg:golang/github.com/spf13/cobra@v1.6.1",
+			},
+			expected: []string{"cpe:2.3:a:spf13:cobra:v1.6.1:*:*:*:*:*:*:*"},
+		},
+		{
+			name: "npm",
+			p: pkg.Package{
+				Name:     "@angular/core",
+				Version:  "14.2.0",
+				Language: pkg.JavaScript,
+				Type:     pkg.NpmPkg,
+				PURL:     "pkg:npm/%40angular/core@14.2.0",
+			},
+			expected: []string{"cpe:2.3:a:angular:core:14.2.0:*:*:*:*:*:*:*"},
+		},
+		{
+			name: "maven",
+			p: pkg.Package{
+				Name:     "log4j-core",
+				Version:  "2.14.1",
+				Language: pkg.Java,
+				Type:     pkg.JavaPkg,
+				PURL:     "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+			},
+			expected: []string{"cpe:2.3:a:apache:log4j-core:2.14.1:*:*:*:*:*:*:*"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			withoutOption := cpeStrings(GenerateWithConfig(test.p, Config{}))
+			withOption := cpeStrings(GenerateWithConfig(test.p, Config{IncludePURLCandidates: true}))
+
+			for _, c := range test.expected {
+				assert.Contains(t, withOption, c)
+			}
+			assert.Subset(t, withOption, withoutOption)
+			assert.Equal(t, len(withOption), strset.New(withOption...).Size(), "expected no duplicate CPEs")
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/scylladb/go-set/strset"
+)
+
+// phpPackagePattern matches OS packages for the php runtime and its extensions across distros
+// (e.g. php81, php8.1-fpm, php81-openssl).
+var phpPackagePattern = regexp.MustCompile(`^php(?:[0-9]+(?:\.[0-9]+)?)?(?:-(?P<extension>[a-z0-9_]+))?$`)
+
+var (
+	// phpRuntimePackages are the packages that make up the php runtime itself (the interpreter and its SAPIs)
+	phpRuntimePackages = strset.New("apache2", "cgi", "cli", "common", "dev", "embed", "fpm", "litespeed", "phpdbg")
+
+	// phpBundledExtensions are the extensions that are distributed with the php source, thus are covered by php CPEs
+	phpBundledExtensions = strset.New(
+		"bcmath", "bz2", "calendar", "ctype", "curl", "dba", "dom", "enchant", "exif", "ffi", "fileinfo", "ftp", "gd",
+		"gettext", "gmp", "iconv", "imap", "intl", "json", "ldap", "mbstring", "mysqli", "mysqlnd", "odbc", "opcache",
+		"openssl", "pcntl", "pdo", "pdo_mysql", "pdo_odbc", "pdo_pgsql", "pdo_sqlite", "pgsql", "phar", "posix", "pspell",
+		"readline", "session", "shmop", "simplexml", "snmp", "soap", "sockets", "sodium", "sqlite3", "sysvmsg", "sysvsem",
+		"sysvshm", "tidy", "tokenizer", "xml", "xmlreader", "xmlwriter", "xsl", "zip", "zlib",
+	)
+)
+
+// phpVendorProducts returns the vendor/product pairs for an OS package of the php runtime or an extension distributed
+// with php, which are all described by php:php. Any other extension (e.g. PECL extensions such as redis or imagick)
+// is maintained outside of php, so nothing is returned and the candidates are found from the package as usual.
+func phpVendorProducts(name string) []vendorProduct {
+	match := phpPackagePattern.FindStringSubmatch(name)
+	if match == nil {
+		return nil
+	}
+
+	extension := match[phpPackagePattern.SubexpIndex("extension")]
+	if extension != "" && !phpRuntimePackages.Has(extension) && !phpBundledExtensions.Has(extension) {
+		return nil
+	}
+
+	return []vendorProduct{{vendor: "php", product: "php"}}
+}
+
+// splitComposerName returns the vendor and project of a composer package named with the vendor/project scheme
+// (e.g. symfony/console -> symfony, console). Empty strings are returned for packages that are not composer packages
+// or are not named with this scheme (e.g. legacy single segment names).
+func splitComposerName(p pkg.Package) (vendor, product string) {
+	if p.Type != pkg.PhpComposerPkg {
+		return "", ""
+	}
+	fields := strings.Split(p.Name, "/")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		return "", ""
+	}
+	return fields[0], fields[1]
+}
@@ -0,0 +1,170 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_phpVendorProducts(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []vendorProduct
+	}{
+		{
+			name:     "php",
+			expected: []vendorProduct{{vendor: "php", product: "php"}},
+		},
+		{
+			name:     "php81",
+			expected: []vendorProduct{{vendor: "php", product: "php"}},
+		},
+		{
+			name:     "php8.1-fpm",
+			expected: []vendorProduct{{vendor: "php", product: "php"}},
+		},
+		{
+			name:     "php81-openssl",
+			expected: []vendorProduct{{vendor: "php", product: "php"}},
+		},
+		{
+			name:     "php8.1-mbstring",
+			expected: []vendorProduct{{vendor: "php", product: "php"}},
+		},
+		{
+			name:     "php-pecl-xdebug",
+			expected: nil,
+		},
+		{
+			name:     "php-pecl-redis5",
+			expected: nil,
+		},
+		{
+			// extensions not distributed with php are not php:php
+			name:     "php8.1-imagick",
+			expected: nil,
+		},
+		{
+			name:     "php81-redis",
+			expected: nil,
+		},
+		{
+			// php libraries packaged by the distro are not extensions
+			name:     "php-symfony-console",
+			expected: nil,
+		},
+		{
+			name:     "phpmyadmin",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, phpVendorProducts(test.name))
+		})
+	}
+}
+
+func Test_splitComposerName(t *testing.T) {
+	tests := []struct {
+		name            string
+		p               pkg.Package
+		expectedVendor  string
+		expectedProduct string
+	}{
+		{
+			name: "vendor and project",
+			p: pkg.Package{
+				Name: "symfony/console",
+				Type: pkg.PhpComposerPkg,
+			},
+			expectedVendor:  "symfony",
+			expectedProduct: "console",
+		},
+		{
+			name: "legacy single segment name",
+			p: pkg.Package{
+				Name: "monolog",
+				Type: pkg.PhpComposerPkg,
+			},
+		},
+		{
+			name: "not a composer package",
+			p: pkg.Package{
+				Name: "symfony/console",
+				Type: pkg.NpmPkg,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vendor, product := splitComposerName(test.p)
+			assert.Equal(t, test.expectedVendor, vendor)
+			assert.Equal(t, test.expectedProduct, product)
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_Composer(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "symfony/console",
+			p: pkg.Package{
+				Name:     "symfony/console",
+				Version:  "5.4.1",
+				Language: pkg.PHP,
+				Type:     pkg.PhpComposerPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:console:console:5.4.1:*:*:*:*:*:*:*",
+				"cpe:2.3:a:symfony:console:5.4.1:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "guzzlehttp/guzzle",
+			p: pkg.Package{
+				Name:     "guzzlehttp/guzzle",
+				Version:  "7.4.5",
+				Language: pkg.PHP,
+				Type:     pkg.PhpComposerPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:guzzle:guzzle:7.4.5:*:*:*:*:*:*:*",
+				"cpe:2.3:a:guzzlehttp:guzzle:7.4.5:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "legacy single segment name",
+			p: pkg.Package{
+				Name:     "monolog",
+				Version:  "1.0.0",
+				Language: pkg.PHP,
+				Type:     pkg.PhpComposerPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:monolog:monolog:1.0.0:*:*:*:*:*:*:*",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, cpeStrings(Generate(test.p)))
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_ComposerTargetSoftware(t *testing.T) {
+	p := pkg.Package{
+		Name:     "symfony/console",
+		Version:  "5.4.1",
+		Language: pkg.PHP,
+		Type:     pkg.PhpComposerPkg,
+	}
+
+	assert.Contains(t, cpeStrings(GenerateWithConfig(p, Config{IncludeTargetSoftware: true})), "cpe:2.3:a:symfony:console:5.4.1:*:*:*:*:php:*:*")
+}
@@ -0,0 +1,48 @@
+package cpe
+
+// prefixIndex is a trie of known names, which allows for finding all known names that prefix a given name without
+// needing to check every known name.
+type prefixIndex struct {
+	children map[rune]*prefixIndex
+	terminal bool
+}
+
+func newPrefixIndex(names ...string) *prefixIndex {
+	idx := &prefixIndex{}
+	for _, name := range names {
+		idx.add(name)
+	}
+	return idx
+}
+
+func (idx *prefixIndex) add(name string) {
+	node := idx
+	for _, r := range name {
+		if node.children == nil {
+			node.children = make(map[rune]*prefixIndex)
+		}
+		child, ok := node.children[r]
+		if !ok {
+			child = &prefixIndex{}
+			node.children[r] = child
+		}
+		node = child
+	}
+	node.terminal = true
+}
+
+// prefixesOf returns all known names that are a prefix of (or equal to) the given name, longest first.
+func (idx *prefixIndex) prefixesOf(name string) (results []string) {
+	node := idx
+	for i, r := range name {
+		child, ok := node.children[r]
+		if !ok {
+			break
+		}
+		node = child
+		if node.terminal {
+			results = append([]string{name[:i+len(string(r))]}, results...)
+		}
+	}
+	return results
+}
@@ -0,0 +1,60 @@
+package cpe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_prefixIndex_prefixesOf(t *testing.T) {
+	idx := newPrefixIndex("node", "nodejs", "redis-server", "tomcat", "ñandú")
+
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{
+			name:     "nodejs18",
+			expected: []string{"nodejs", "node"},
+		},
+		{
+			name:     "node",
+			expected: []string{"node"},
+		},
+		{
+			name:     "redis",
+			expected: nil,
+		},
+		{
+			name:     "tomcat10",
+			expected: []string{"tomcat"},
+		},
+		{
+			name:     "ñandú-2",
+			expected: []string{"ñandú"},
+		},
+		{
+			name:     "",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, idx.prefixesOf(test.name))
+		})
+	}
+}
+
+func Benchmark_prefixIndex_prefixesOf(b *testing.B) {
+	var names []string
+	for i := 0; i < 10000; i++ {
+		names = append(names, fmt.Sprintf("product-%d", i))
+	}
+	idx := newPrefixIndex(names...)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.prefixesOf("product-9999-extra")
+	}
+}
@@ -0,0 +1,28 @@
+package cpe
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/anchore/syft/syft/pkg"
+	"gopkg.in/yaml.v2"
+)
+
+// ReadProductCandidates reads additional product candidates (for Config.ProductCandidates) from a YAML or JSON document
+// keyed by package type and then package name. For example:
+//
+//	gem:
+//	  acme-widgets: [widgets]
+//
+// When a package name is listed more than once for the same type, the last entry wins.
+func ReadProductCandidates(reader io.Reader) (map[pkg.Type]map[string][]string, error) {
+	var candidates map[pkg.Type]map[string][]string
+	if err := yaml.NewDecoder(reader).Decode(&candidates); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to parse CPE product candidates: %w", err)
+	}
+	return candidates, nil
+}
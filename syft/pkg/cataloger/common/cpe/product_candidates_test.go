@@ -0,0 +1,81 @@
+package cpe
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadProductCandidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[pkg.Type]map[string][]string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "yaml",
+			input: `
+gem:
+  acme-widgets: [widgets]
+java-archive:
+  acme-core:
+    - acme
+    - acme_core
+`,
+			expected: map[pkg.Type]map[string][]string{
+				pkg.GemPkg: {
+					"acme-widgets": {"widgets"},
+				},
+				pkg.JavaPkg: {
+					"acme-core": {"acme", "acme_core"},
+				},
+			},
+		},
+		{
+			name:  "json",
+			input: `{"gem": {"acme-widgets": ["widgets"]}}`,
+			expected: map[pkg.Type]map[string][]string{
+				pkg.GemPkg: {
+					"acme-widgets": {"widgets"},
+				},
+			},
+		},
+		{
+			name: "duplicate keys override",
+			input: `
+gem:
+  acme-widgets: [widgets]
+  acme-widgets: [acme_widgets]
+`,
+			expected: map[pkg.Type]map[string][]string{
+				pkg.GemPkg: {
+					"acme-widgets": {"acme_widgets"},
+				},
+			},
+		},
+		{
+			name:     "empty",
+			input:    "",
+			expected: nil,
+		},
+		{
+			name:    "bad structure",
+			input:   `gem: [widgets]`,
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			actual, err := ReadProductCandidates(strings.NewReader(test.input))
+			test.wantErr(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
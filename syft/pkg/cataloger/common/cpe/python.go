@@ -1,6 +1,14 @@
 package cpe
 
-import "github.com/anchore/syft/syft/pkg"
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// pythonNameSeparatorsPattern matches runs of the separators that PyPI considers equivalent in project names (PEP 503)
+var pythonNameSeparatorsPattern = regexp.MustCompile(`[-_.]+`)
 
 func candidateVendorsForPython(p pkg.Package) fieldCandidateSet {
 	metadata, ok := p.Metadata.(pkg.PythonPackageMetadata)
@@ -25,5 +33,40 @@ func candidateVendorsForPython(p pkg.Package) fieldCandidateSet {
 		})
 	}
 
+	// packages resolved from a source repository (e.g. a git dependency in a poetry.lock) are owned by the repository owner
+	if owner, _ := pythonSourceRepo(metadata); owner != "" {
+		vendors.add(fieldCandidate{
+			value:                 owner,
+			disallowSubSelections: true,
+		})
+	}
+
 	return vendors
 }
+
+func candidateProductsForPython(p pkg.Package) []string {
+	metadata, ok := p.Metadata.(pkg.PythonPackageMetadata)
+	if !ok {
+		return nil
+	}
+
+	if _, name := pythonSourceRepo(metadata); name != "" {
+		return []string{name}
+	}
+	return nil
+}
+
+// pythonSourceRepo returns the owner and name of the repository the package was resolved from, if it is hosted on a
+// well-known forge (e.g. https://github.com/psf/requests.git -> psf, requests).
+func pythonSourceRepo(metadata pkg.PythonPackageMetadata) (string, string) {
+	if metadata.DirectURLOrigin == nil {
+		return "", ""
+	}
+	return sourceRepoFromURL(metadata.DirectURLOrigin.URL)
+}
+
+// normalizePythonName returns the PEP 503 normalized form of the given project name, which is lowercased with all runs of
+// separators collapsed into a single hyphen (e.g. Flask--SQLAlchemy and flask.sqlalchemy -> flask-sqlalchemy).
+func normalizePythonName(name string) string {
+	return strings.ToLower(pythonNameSeparatorsPattern.ReplaceAllString(name, "-"))
+}
@@ -0,0 +1,36 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_normalizePythonName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "Flask-SQLAlchemy",
+			expected: "flask-sqlalchemy",
+		},
+		{
+			input:    "zope.interface",
+			expected: "zope-interface",
+		},
+		{
+			input:    "Pillow",
+			expected: "pillow",
+		},
+		{
+			input:    "foo._-bar",
+			expected: "foo-bar",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expected, normalizePythonName(test.input))
+		})
+	}
+}
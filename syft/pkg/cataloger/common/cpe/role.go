@@ -0,0 +1,31 @@
+package cpe
+
+import (
+	"strings"
+)
+
+// productRoles are the name tokens that indicate the package is only the server or client part of a project
+var productRoles = []string{"server", "client"}
+
+// roleProducts returns the project and the role-specific product for a package name that indicates a server or client
+// role (e.g. postgresql14-server -> [postgresql, postgresql_server]). Any version that is part of the project name is
+// dropped, since it is typically the packaged major version and not part of the product.
+func roleProducts(name string) []string {
+	tokens := strings.FieldsFunc(strings.ToLower(name), trimHyphenOrUnderscore)
+	for i, token := range tokens {
+		if i == 0 {
+			continue
+		}
+		for _, role := range productRoles {
+			if token != role {
+				continue
+			}
+			project := strings.TrimRight(strings.Join(tokens[:i], "-"), "0123456789.")
+			if project == "" {
+				return nil
+			}
+			return []string{project, project + "_" + role}
+		}
+	}
+	return nil
+}
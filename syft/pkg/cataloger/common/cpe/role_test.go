@@ -0,0 +1,59 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_roleProducts(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+	}{
+		{
+			name:     "postgresql-server",
+			expected: []string{"postgresql", "postgresql_server"},
+		},
+		{
+			name:     "postgresql14-server",
+			expected: []string{"postgresql", "postgresql_server"},
+		},
+		{
+			name:     "mariadb-client-core",
+			expected: []string{"mariadb", "mariadb_client"},
+		},
+		{
+			name:     "server",
+			expected: nil,
+		},
+		{
+			name:     "serverless",
+			expected: nil,
+		},
+		{
+			name:     "openssh",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, roleProducts(test.name))
+		})
+	}
+}
+
+func TestGenerateWithConfig_IncludeRoleProducts(t *testing.T) {
+	p := pkg.Package{
+		Name:    "postgresql14-server",
+		Version: "14.5",
+		Type:    pkg.RpmPkg,
+	}
+
+	assert.NotContains(t, cpeStrings(GenerateWithConfig(p, Config{})), "cpe:2.3:a:postgresql:postgresql:14.5:*:*:*:*:*:*:*")
+
+	actual := cpeStrings(GenerateWithConfig(p, Config{IncludeRoleProducts: true}))
+	assert.Contains(t, actual, "cpe:2.3:a:postgresql:postgresql:14.5:*:*:*:*:*:*:*")
+	assert.Contains(t, actual, "cpe:2.3:a:postgresql:postgresql_server:14.5:*:*:*:*:*:*:*")
+}
@@ -0,0 +1,40 @@
+package cpe
+
+import (
+	"net/url"
+	"strings"
+)
+
+// sourceRepoHosts are the hosts where the owner and name of a project can be read from the repository path
+var sourceRepoHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// sourceRepoFromURL returns the owner and name of the repository at the given URL, if it is hosted on a well-known
+// forge (e.g. git+https://github.com/psf/requests.git -> psf, requests).
+func sourceRepoFromURL(rawURL string) (string, string) {
+	rawURL = strings.TrimPrefix(rawURL, "git+")
+	if strings.HasPrefix(rawURL, "git@") {
+		// scp-like syntax (e.g. git@github.com:psf/requests.git)
+		rawURL = "ssh://" + strings.Replace(strings.TrimPrefix(rawURL, "git@"), ":", "/", 1)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || !isSourceRepoHost(u.Hostname()) {
+		return "", ""
+	}
+
+	fields := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(fields) < 2 {
+		return "", ""
+	}
+
+	return strings.ToLower(fields[0]), strings.ToLower(strings.TrimSuffix(fields[1], ".git"))
+}
+
+func isSourceRepoHost(host string) bool {
+	for _, h := range sourceRepoHosts {
+		if strings.EqualFold(host, h) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package cpe
+
+import (
+	"net/url"
+	"strings"
+)
+
+// swiftRepoFromName returns the owner and name of the repository that a swift package is identified by, since swift
+// package manager dependencies are named after the URL of their repository (e.g. https://github.com/Alamofire/Alamofire.git
+// -> alamofire, alamofire). Empty strings are returned if the name is not a repository URL.
+func swiftRepoFromName(name string) (string, string) {
+	name = strings.TrimPrefix(name, "git+")
+	if !strings.Contains(name, "://") {
+		// note: url.Parse requires a scheme for correct processing, which may be omitted (e.g. github.com/Alamofire/Alamofire)
+		name = "https://" + name
+	}
+
+	u, err := url.Parse(name)
+	if err != nil || !strings.Contains(u.Hostname(), ".") {
+		return "", ""
+	}
+
+	fields := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		return "", ""
+	}
+
+	return strings.ToLower(fields[0]), strings.ToLower(strings.TrimSuffix(fields[1], ".git"))
+}
+
+func candidateVendorForSwift(name string) string {
+	vendor, _ := swiftRepoFromName(name)
+	return vendor
+}
+
+func candidateProductForSwift(name string) string {
+	_, product := swiftRepoFromName(name)
+	return product
+}
@@ -0,0 +1,69 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_swiftRepoFromName(t *testing.T) {
+	tests := []struct {
+		name            string
+		expectedVendor  string
+		expectedProduct string
+	}{
+		{
+			name:            "https://github.com/Alamofire/Alamofire.git",
+			expectedVendor:  "alamofire",
+			expectedProduct: "alamofire",
+		},
+		{
+			name:            "https://github.com/apple/swift-nio",
+			expectedVendor:  "apple",
+			expectedProduct: "swift-nio",
+		},
+		{
+			name:            "github.com/ReactiveX/RxSwift.git",
+			expectedVendor:  "reactivex",
+			expectedProduct: "rxswift",
+		},
+		{
+			name:            "git+ssh://gitlab.example.com/mobile/networking.git",
+			expectedVendor:  "mobile",
+			expectedProduct: "networking",
+		},
+		{
+			// a repository path must have an owner and a name
+			name: "https://github.com/Alamofire",
+		},
+		{
+			// not a repository URL
+			name: "Alamofire",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vendor, product := swiftRepoFromName(test.name)
+			assert.Equal(t, test.expectedVendor, vendor)
+			assert.Equal(t, test.expectedProduct, product)
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_Swift(t *testing.T) {
+	p := pkg.Package{
+		Name:     "https://github.com/Alamofire/Alamofire.git",
+		Version:  "5.6.4",
+		Language: pkg.Swift,
+	}
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:alamofire:alamofire:5.6.4:*:*:*:*:*:*:*",
+	}, cpeStrings(Generate(p)))
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:alamofire:alamofire:5.6.4:*:*:*:*:*:*:*",
+		"cpe:2.3:a:alamofire:alamofire:5.6.4:*:*:*:*:swift:*:*",
+	}, cpeStrings(GenerateWithConfig(p, Config{IncludeTargetSoftware: true})))
+}
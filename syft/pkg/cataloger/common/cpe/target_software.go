@@ -0,0 +1,75 @@
+package cpe
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// targetSoftwareByLanguage is the target software that NVD uses for packages of each language ecosystem
+var targetSoftwareByLanguage = map[pkg.Language][]string{
+	pkg.Go:         {"go"},
+	pkg.JavaScript: {"node.js"},
+	pkg.PHP:        {"php"},
+	pkg.Python:     {"python"},
+	pkg.Ruby:       {"ruby"},
+	pkg.Swift:      {"swift"},
+	// NVD is not consistent in naming the rust platform after the language or the package manager
+	pkg.Rust: {"rust", "cargo"},
+}
+
+// targetSoftwareByPackageType is the target software that NVD uses for packages of each package type, which is only
+// considered when the language does not indicate the target software (e.g. jenkins plugins are java packages).
+var targetSoftwareByPackageType = map[pkg.Type][]string{
+	pkg.DotnetPkg:        {".net", "dotnet"},
+	pkg.GemPkg:           {"ruby"},
+	pkg.GoModulePkg:      {"go"},
+	pkg.JenkinsPluginPkg: {"jenkins"},
+	pkg.NpmPkg:           {"node.js"},
+	pkg.PhpComposerPkg:   {"php"},
+	pkg.PythonPkg:        {"python"},
+	pkg.RustPkg:          {"rust", "cargo"},
+}
+
+// candidateTargetSoftwareAttrs returns the target software values that NVD uses to describe the platform that the
+// given package is built for.
+func candidateTargetSoftwareAttrs(p pkg.Package) []string {
+	if p.Type == pkg.DotnetPkg {
+		return candidateTargetSoftwareAttrsForDotnet(p)
+	}
+
+	if targetSWs, ok := targetSoftwareByLanguage[p.Language]; ok {
+		return targetSWs
+	}
+
+	if targetSWs, ok := targetSoftwareByPackageType[p.Type]; ok {
+		return targetSWs
+	}
+
+	return nil
+}
+
+// candidateTargetSoftwareAttrsForDotnet distinguishes between packages for the .NET Framework and .NET (Core), which
+// NVD lists with different target software values. ASP.NET packages are additionally listed under asp.net.
+func candidateTargetSoftwareAttrsForDotnet(p pkg.Package) []string {
+	var targetFramework string
+	switch metadata := p.Metadata.(type) {
+	case pkg.DotnetDepsMetadata:
+		targetFramework = metadata.TargetFramework
+	case *pkg.DotnetDepsMetadata:
+		targetFramework = metadata.TargetFramework
+	}
+
+	var targetSWs []string
+	switch {
+	case strings.HasPrefix(targetFramework, ".NETFramework"):
+		targetSWs = []string{".net_framework"}
+	default:
+		targetSWs = append(targetSWs, targetSoftwareByPackageType[pkg.DotnetPkg]...)
+	}
+
+	if isASPNetPackage(p) {
+		targetSWs = append(targetSWs, "asp.net")
+	}
+	return targetSWs
+}
@@ -0,0 +1,168 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_candidateTargetSoftwareAttrs(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: ".NET Framework",
+			p: pkg.Package{
+				Name:         "Newtonsoft.Json",
+				Type:         pkg.DotnetPkg,
+				MetadataType: pkg.DotnetDepsMetadataType,
+				Metadata: pkg.DotnetDepsMetadata{
+					TargetFramework: ".NETFramework,Version=v4.7.2",
+				},
+			},
+			expected: []string{".net_framework"},
+		},
+		{
+			name: ".NET Core",
+			p: pkg.Package{
+				Name:         "Newtonsoft.Json",
+				Type:         pkg.DotnetPkg,
+				MetadataType: pkg.DotnetDepsMetadataType,
+				Metadata: &pkg.DotnetDepsMetadata{
+					TargetFramework: ".NETCoreApp,Version=v6.0",
+				},
+			},
+			expected: []string{".net", "dotnet"},
+		},
+		{
+			name: "unknown target framework",
+			p: pkg.Package{
+				Name:         "Newtonsoft.Json",
+				Type:         pkg.DotnetPkg,
+				MetadataType: pkg.DotnetDepsMetadataType,
+				Metadata:     pkg.DotnetDepsMetadata{},
+			},
+			expected: []string{".net", "dotnet"},
+		},
+		{
+			name: "ASP.NET Core",
+			p: pkg.Package{
+				Name:         "Microsoft.AspNetCore.Mvc",
+				Type:         pkg.DotnetPkg,
+				MetadataType: pkg.DotnetDepsMetadataType,
+				Metadata: pkg.DotnetDepsMetadata{
+					TargetFramework: ".NETCoreApp,Version=v6.0",
+				},
+			},
+			expected: []string{".net", "dotnet", "asp.net"},
+		},
+		{
+			name: "by language",
+			p: pkg.Package{
+				Name:     "lodash",
+				Language: pkg.JavaScript,
+				Type:     pkg.NpmPkg,
+			},
+			expected: []string{"node.js"},
+		},
+		{
+			name: "rust crate",
+			p: pkg.Package{
+				Name:     "openssl-sys",
+				Language: pkg.Rust,
+				Type:     pkg.RustPkg,
+			},
+			expected: []string{"rust", "cargo"},
+		},
+		{
+			name: "swift package",
+			p: pkg.Package{
+				Name:     "https://github.com/Alamofire/Alamofire.git",
+				Language: pkg.Swift,
+			},
+			expected: []string{"swift"},
+		},
+		{
+			name: "by package type when the language has no target software",
+			p: pkg.Package{
+				Name:     "git-client",
+				Language: pkg.Java,
+				Type:     pkg.JenkinsPluginPkg,
+			},
+			expected: []string{"jenkins"},
+		},
+		{
+			name: "no target software",
+			p: pkg.Package{
+				Name: "openssl",
+				Type: pkg.DebPkg,
+			},
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, candidateTargetSoftwareAttrs(test.p))
+		})
+	}
+}
+
+func TestGenerateWithConfig_RustCrates(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "hyphenated crate",
+			p: pkg.Package{
+				Name:     "openssl-sys",
+				Version:  "0.9.75",
+				Language: pkg.Rust,
+				Type:     pkg.RustPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:openssl-sys:openssl-sys:0.9.75:*:*:*:*:*:*:*",
+				"cpe:2.3:a:openssl-sys:openssl-sys:0.9.75:*:*:*:*:rust:*:*",
+				"cpe:2.3:a:openssl-sys:openssl-sys:0.9.75:*:*:*:*:cargo:*:*",
+				"cpe:2.3:a:openssl-sys:openssl_sys:0.9.75:*:*:*:*:*:*:*",
+				"cpe:2.3:a:openssl-sys:openssl_sys:0.9.75:*:*:*:*:rust:*:*",
+				"cpe:2.3:a:openssl-sys:openssl_sys:0.9.75:*:*:*:*:cargo:*:*",
+				"cpe:2.3:a:openssl_sys:openssl-sys:0.9.75:*:*:*:*:*:*:*",
+				"cpe:2.3:a:openssl_sys:openssl-sys:0.9.75:*:*:*:*:rust:*:*",
+				"cpe:2.3:a:openssl_sys:openssl-sys:0.9.75:*:*:*:*:cargo:*:*",
+				"cpe:2.3:a:openssl_sys:openssl_sys:0.9.75:*:*:*:*:*:*:*",
+				"cpe:2.3:a:openssl_sys:openssl_sys:0.9.75:*:*:*:*:rust:*:*",
+				"cpe:2.3:a:openssl_sys:openssl_sys:0.9.75:*:*:*:*:cargo:*:*",
+				"cpe:2.3:a:openssl:openssl-sys:0.9.75:*:*:*:*:*:*:*",
+				"cpe:2.3:a:openssl:openssl-sys:0.9.75:*:*:*:*:rust:*:*",
+				"cpe:2.3:a:openssl:openssl-sys:0.9.75:*:*:*:*:cargo:*:*",
+				"cpe:2.3:a:openssl:openssl_sys:0.9.75:*:*:*:*:*:*:*",
+				"cpe:2.3:a:openssl:openssl_sys:0.9.75:*:*:*:*:rust:*:*",
+				"cpe:2.3:a:openssl:openssl_sys:0.9.75:*:*:*:*:cargo:*:*",
+			},
+		},
+		{
+			name: "single word crate",
+			p: pkg.Package{
+				Name:     "hyper",
+				Version:  "0.14.20",
+				Language: pkg.Rust,
+				Type:     pkg.RustPkg,
+			},
+			expected: []string{
+				"cpe:2.3:a:hyper:hyper:0.14.20:*:*:*:*:*:*:*",
+				"cpe:2.3:a:hyper:hyper:0.14.20:*:*:*:*:rust:*:*",
+				"cpe:2.3:a:hyper:hyper:0.14.20:*:*:*:*:cargo:*:*",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, cpeStrings(GenerateWithConfig(test.p, Config{IncludeTargetSoftware: true})))
+		})
+	}
+}
@@ -1,6 +1,21 @@
 package cpe
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// asciiFoldings are the replacements for letters that do not decompose into an ASCII letter and a combining mark
+var asciiFoldings = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "Æ", "AE", "œ", "oe", "Œ", "OE", "ø", "o", "Ø", "O", "ł", "l", "Ł", "L", "đ", "d", "Đ", "D",
+	"þ", "th", "Þ", "TH",
+)
+
+// concatenationSeparatorPreference picks the canonical form of vendor and product candidates that differ only by
+// separators when concatenating, preferring the form found in most package names
+var concatenationSeparatorPreference = []string{"-", "_", ""}
 
 func stripEmailSuffix(email string) string {
 	return strings.Split(email, "@")[0]
@@ -19,3 +34,105 @@ func normalizeName(name string) string {
 	name = strings.TrimSpace(strings.ToLower(name))
 	return strings.ReplaceAll(name, " ", "")
 }
+
+// candidateProductsForDisplayName returns the forms NVD uses for names that contain spaces, such as the display names of
+// installed programs, which are either joined with underscores or have the spaces removed
+// (e.g. Google Chrome -> [google_chrome, googlechrome]). Names without spaces have no additional candidates.
+func candidateProductsForDisplayName(name string) []string {
+	fields := strings.Fields(strings.ToLower(name))
+	if len(fields) < 2 {
+		return nil
+	}
+	return []string{strings.Join(fields, "_"), strings.Join(fields, "")}
+}
+
+// collapseSeparators collapses runs of hyphens and underscores into the first separator of the run and removes any
+// leading or trailing separators (e.g. foo--bar -> foo-bar, -foo- -> foo).
+func collapseSeparators(name string) string {
+	var sb strings.Builder
+	var previousWasSeparator bool
+	for _, r := range name {
+		isSeparator := r == '-' || r == '_'
+		if isSeparator && previousWasSeparator {
+			continue
+		}
+		previousWasSeparator = isSeparator
+		sb.WriteRune(r)
+	}
+	return strings.Trim(sb.String(), "-_")
+}
+
+// asciiFold replaces accented and other unicode letters with their closest ASCII form (e.g. café -> cafe). If the
+// given value cannot be fully represented with ASCII then an empty string is returned.
+func asciiFold(value string) string {
+	var sb strings.Builder
+	for _, r := range norm.NFD.String(asciiFoldings.Replace(value)) {
+		if unicode.Is(unicode.Mn, r) {
+			// drop combining marks (accents)
+			continue
+		}
+		if r > unicode.MaxASCII {
+			return ""
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// addASCIIFoldedVariations returns the given values along with the ASCII-folded form of any value that is not already ASCII.
+func addASCIIFoldedVariations(values []string) []string {
+	results := append([]string(nil), values...)
+	for _, value := range values {
+		if folded := asciiFold(value); folded != "" && folded != value {
+			results = append(results, folded)
+		}
+	}
+	return results
+}
+
+// addVendorProductConcatenations returns the given products along with the vendor+product and vendor-product forms of
+// every vendor and product pair (e.g. mongo + db -> [mongodb, mongo-db]). Only the canonical form of candidates that
+// differ by separators is concatenated (e.g. ruby-lang, not ruby_lang). Pairs where the product already starts with the
+// vendor are skipped, as are any forms that are already a product candidate.
+func addVendorProductConcatenations(vendors, products []string) []string {
+	seen := make(map[string]struct{}, len(products))
+	for _, product := range products {
+		seen[product] = struct{}{}
+	}
+
+	results := append([]string(nil), products...)
+	for _, vendor := range keepCanonicalSeparatorVariants(vendors, concatenationSeparatorPreference) {
+		for _, product := range keepCanonicalSeparatorVariants(products, concatenationSeparatorPreference) {
+			if vendor == "" || strings.HasPrefix(product, vendor) {
+				continue
+			}
+			for _, concatenated := range []string{vendor + product, vendor + "-" + product} {
+				if _, ok := seen[concatenated]; ok {
+					continue
+				}
+				seen[concatenated] = struct{}{}
+				results = append(results, concatenated)
+			}
+		}
+	}
+	return results
+}
+
+// appendMissing appends each of the given additions that is not already in values.
+func appendMissing(values []string, additions ...string) []string {
+	for _, addition := range additions {
+		if !containsString(values, addition) {
+			values = append(values, addition)
+		}
+	}
+	return values
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,8 @@ package cpe
 import (
 	"testing"
 
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/scylladb/go-set/strset"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,3 +71,208 @@ func Test_normalizePersonName(t *testing.T) {
 		})
 	}
 }
+
+func Test_candidateProductsForDisplayName(t *testing.T) {
+	tests := []struct {
+		input   string
+		expects []string
+	}{
+		{
+			input:   "Google Chrome",
+			expects: []string{"google_chrome", "googlechrome"},
+		},
+		{
+			// note: extra spaces
+			input:   "  Mozilla   Firefox ESR ",
+			expects: []string{"mozilla_firefox_esr", "mozillafirefoxesr"},
+		},
+		{
+			input:   "curl",
+			expects: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expects, candidateProductsForDisplayName(test.input))
+		})
+	}
+}
+
+func Test_collapseSeparators(t *testing.T) {
+	tests := []struct {
+		input   string
+		expects string
+	}{
+		{
+			input:   "foo--bar",
+			expects: "foo-bar",
+		},
+		{
+			input:   "-foo-",
+			expects: "foo",
+		},
+		{
+			input:   "foo__bar_",
+			expects: "foo_bar",
+		},
+		{
+			input:   "foo-_bar",
+			expects: "foo-bar",
+		},
+		{
+			input:   "foo-bar",
+			expects: "foo-bar",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expects, collapseSeparators(test.input))
+		})
+	}
+}
+
+func Test_asciiFold(t *testing.T) {
+	tests := []struct {
+		input   string
+		expects string
+	}{
+		{
+			input:   "café",
+			expects: "cafe",
+		},
+		{
+			input:   "señor-ñandú",
+			expects: "senor-nandu",
+		},
+		{
+			input:   "straße",
+			expects: "strasse",
+		},
+		{
+			input:   "ascii",
+			expects: "ascii",
+		},
+		{
+			input:   "日本語",
+			expects: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expects, asciiFold(test.input))
+		})
+	}
+}
+
+func Test_addVendorProductConcatenations(t *testing.T) {
+	tests := []struct {
+		name     string
+		vendors  []string
+		products []string
+		expects  []string
+	}{
+		{
+			name:     "concatenates vendor and product",
+			vendors:  []string{"mongo"},
+			products: []string{"db"},
+			expects:  []string{"db", "mongodb", "mongo-db"},
+		},
+		{
+			name:     "skips products already prefixed with the vendor",
+			vendors:  []string{"mongodb"},
+			products: []string{"mongodb", "mongodb-driver"},
+			expects:  []string{"mongodb", "mongodb-driver"},
+		},
+		{
+			name:     "dedupes existing candidates",
+			vendors:  []string{"mongo", "mongo"},
+			products: []string{"db", "mongodb"},
+			expects:  []string{"db", "mongodb", "mongo-db"},
+		},
+		{
+			name:     "no vendors",
+			products: []string{"db"},
+			expects:  []string{"db"},
+		},
+		{
+			name:     "only concatenates the canonical separator variants",
+			vendors:  []string{"ruby-lang", "ruby_lang", "rubylang"},
+			products: []string{"rack-test", "rack_test"},
+			expects:  []string{"rack-test", "rack_test", "ruby-langrack-test", "ruby-lang-rack-test"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expects, addVendorProductConcatenations(test.vendors, test.products))
+		})
+	}
+}
+
+func Test_addVariations_doesNotShareCallerArray(t *testing.T) {
+	// values with spare capacity, where appending to the given slice would otherwise overwrite the results
+	values := make([]string, 1, 10)
+	values[0] = "café"
+
+	concatenated := addVendorProductConcatenations([]string{"acme"}, values)
+	folded := addASCIIFoldedVariations(values)
+	_ = append(values, "other")
+
+	assert.Equal(t, []string{"café", "acmecafé", "acme-café"}, concatenated)
+	assert.Equal(t, []string{"café", "cafe"}, folded)
+}
+
+func Test_appendMissing(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, appendMissing([]string{"a", "b"}, "b", "c", "c"))
+	assert.Equal(t, []string{"a"}, appendMissing(nil, "a"))
+}
+
+func TestGenerateWithConfig_IncludeASCIIFolded(t *testing.T) {
+	p := pkg.Package{
+		Name:    "café",
+		Version: "1.0.0",
+		Type:    pkg.RpmPkg,
+	}
+
+	// unicode values are not valid within a CPE, so nothing can be generated without folding
+	assert.Empty(t, GenerateWithConfig(p, Config{}))
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:cafe:cafe:1.0.0:*:*:*:*:*:*:*",
+	}, cpeStrings(GenerateWithConfig(p, Config{IncludeASCIIFolded: true})))
+}
+
+func TestGenerateWithConfig_IncludeVendorProductConcatenations(t *testing.T) {
+	p := pkg.Package{
+		Name:    "db",
+		Version: "1.0",
+		Type:    pkg.GemPkg,
+		Metadata: pkg.GemMetadata{
+			Authors: []string{"mongo"},
+		},
+		MetadataType: pkg.GemMetadataType,
+	}
+
+	concatenated := []string{
+		"cpe:2.3:a:mongo:mongodb:1.0:*:*:*:*:*:*:*",
+		"cpe:2.3:a:mongo:mongo-db:1.0:*:*:*:*:*:*:*",
+	}
+
+	withoutOption := cpeStrings(GenerateWithConfig(p, Config{}))
+	withOption := cpeStrings(GenerateWithConfig(p, Config{IncludeVendorProductConcatenations: true}))
+
+	for _, c := range concatenated {
+		assert.NotContains(t, withoutOption, c)
+		assert.Contains(t, withOption, c)
+	}
+	assert.Subset(t, withOption, withoutOption)
+	assert.Equal(t, len(withOption), strset.New(withOption...).Size(), "expected no duplicate CPEs")
+}
+
+func TestGeneratePackageCPEs_DisplayName(t *testing.T) {
+	actual := cpeStrings(Generate(pkg.Package{
+		Name:    "Google Chrome",
+		Version: "118.0.5993.70",
+	}))
+	assert.Contains(t, actual, "cpe:2.3:a:google:google_chrome:118.0.5993.70:*:*:*:*:*:*:*")
+	assert.Contains(t, actual, "cpe:2.3:a:google:googlechrome:118.0.5993.70:*:*:*:*:*:*:*")
+}
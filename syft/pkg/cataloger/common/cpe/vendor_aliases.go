@@ -0,0 +1,62 @@
+package cpe
+
+import "github.com/anchore/syft/syft/pkg"
+
+// defaultVendorAliases maps product candidates to the vendors of the organizations that are known to own the product
+// in NVD, even though that vendor cannot be inferred from the package metadata (e.g. jetty -> eclipse). These are only
+// applied to java and OS packages, see vendorAliasPackageTypes.
+var defaultVendorAliases = map[string][]string{
+	// Eclipse Foundation projects
+	"birt":        {"eclipse"},
+	"californium": {"eclipse"},
+	"che":         {"eclipse"},
+	"glassfish":   {"eclipse"},
+	"hono":        {"eclipse"},
+	"jersey":      {"eclipse"},
+	"jetty":       {"eclipse"},
+	"jgit":        {"eclipse"},
+	"kura":        {"eclipse"},
+	"mojarra":     {"eclipse"},
+	"mosquitto":   {"eclipse"},
+	"openj9":      {"eclipse"},
+	"theia":       {"eclipse"},
+	"vert.x":      {"eclipse"},
+	"vertx":       {"eclipse"},
+
+	// FasterXML projects (e.g. jackson-databind)
+	"jackson": {"fasterxml"},
+
+	// Oracle products
+	"graalvm": {"oracle"},
+	"jdk":     {"oracle"},
+	"jre":     {"oracle"},
+	"mysql":   {"oracle"},
+	"vm":      {"oracle"},
+}
+
+// vendorAliasPackageTypes are the package types the default vendor aliases are applied to. The aliased products are
+// java projects or software distributed as OS packages, where in other ecosystems these names tend to be unrelated
+// projects (e.g. the mysql npm package is a client library not owned by oracle).
+var vendorAliasPackageTypes = map[pkg.Type]bool{
+	pkg.AlpmPkg:          true,
+	pkg.ApkPkg:           true,
+	pkg.DebPkg:           true,
+	pkg.JavaPkg:          true,
+	pkg.JenkinsPluginPkg: true,
+	pkg.PortagePkg:       true,
+	pkg.RpmPkg:           true,
+}
+
+// findVendorAliases returns the aliased vendors for all given products of a package with the given type. Aliases found
+// within the given overrides take precedence over (replace) the default aliases for the same product, and are applied
+// to all package types.
+func findVendorAliases(ty pkg.Type, overrides map[string][]string, products ...string) (vendors []string) {
+	for _, product := range products {
+		aliases, ok := overrides[product]
+		if !ok && vendorAliasPackageTypes[ty] {
+			aliases = defaultVendorAliases[product]
+		}
+		vendors = append(vendors, aliases...)
+	}
+	return vendors
+}
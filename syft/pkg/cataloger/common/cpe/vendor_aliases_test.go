@@ -0,0 +1,137 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandidateVendor_VendorAliases(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		cfg      Config
+		expected []string
+	}{
+		{
+			name: "eclipse project",
+			p: pkg.Package{
+				Name: "jetty",
+				Type: pkg.JavaPkg,
+			},
+			cfg:      DefaultConfig(),
+			expected: []string{"jetty", "eclipse"},
+		},
+		{
+			name: "eclipse sub-project",
+			p: pkg.Package{
+				Name: "jetty-server",
+				Type: pkg.JavaPkg,
+			},
+			cfg:      DefaultConfig(),
+			expected: []string{"jetty-server", "jetty_server", "jetty", "eclipse"},
+		},
+		{
+			name: "oracle product",
+			p: pkg.Package{
+				Name: "mysql",
+				Type: pkg.DebPkg,
+			},
+			cfg:      DefaultConfig(),
+			expected: []string{"mysql", "oracle"},
+		},
+		{
+			name: "npm package sharing an oracle product name",
+			p: pkg.Package{
+				Name: "mysql",
+				Type: pkg.NpmPkg,
+			},
+			cfg:      DefaultConfig(),
+			expected: []string{"mysql"},
+		},
+		{
+			name: "python package sharing an oracle product name",
+			p: pkg.Package{
+				Name: "mysql",
+				Type: pkg.PythonPkg,
+			},
+			cfg:      DefaultConfig(),
+			expected: []string{"mysql"},
+		},
+		{
+			name: "oracle sub-project",
+			p: pkg.Package{
+				Name: "graalvm-sdk",
+				Type: pkg.JavaPkg,
+			},
+			cfg:      DefaultConfig(),
+			expected: []string{"graalvm-sdk", "graalvm_sdk", "graalvm", "oracle"},
+		},
+		{
+			name: "fasterxml jackson artifact",
+			p: pkg.Package{
+				Name: "jackson-databind",
+				Type: pkg.JavaPkg,
+			},
+			cfg:      DefaultConfig(),
+			expected: []string{"jackson-databind", "jackson_databind", "jackson", "fasterxml"},
+		},
+		{
+			name: "overridden alias",
+			p: pkg.Package{
+				Name: "jetty",
+				Type: pkg.JavaPkg,
+			},
+			cfg: Config{
+				VendorAliases: map[string][]string{
+					"jetty": {"mortbay"},
+				},
+			},
+			expected: []string{"jetty", "mortbay"},
+		},
+		{
+			name: "removed alias",
+			p: pkg.Package{
+				Name: "jetty",
+				Type: pkg.JavaPkg,
+			},
+			cfg: Config{
+				VendorAliases: map[string][]string{
+					"jetty": nil,
+				},
+			},
+			expected: []string{"jetty"},
+		},
+		{
+			name: "configured alias applies to all package types",
+			p: pkg.Package{
+				Name: "mysql",
+				Type: pkg.NpmPkg,
+			},
+			cfg: Config{
+				VendorAliases: map[string][]string{
+					"mysql": {"mysqljs"},
+				},
+			},
+			expected: []string{"mysql", "mysqljs"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, candidateVendors(test.p, test.cfg))
+		})
+	}
+}
+
+func TestGeneratePackageCPEs_Jackson(t *testing.T) {
+	p := pkg.Package{
+		Name:     "jackson-databind",
+		Version:  "2.13.2",
+		Language: pkg.Java,
+		Type:     pkg.JavaPkg,
+	}
+
+	assert.Contains(t, cpeStrings(Generate(p)), "cpe:2.3:a:fasterxml:jackson-databind:2.13.2:*:*:*:*:*:*:*")
+}
@@ -0,0 +1,145 @@
+package cpe
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/facebookincubator/nvdtools/wfn"
+)
+
+// preReleaseVersionPattern matches versions that are a pre-release of some release version (e.g. 1.2.3-beta or 2.0.0-rc.1).
+var preReleaseVersionPattern = regexp.MustCompile(`(?i)^(?P<release>[0-9]+(\.[0-9]+)*)[-_.]?(alpha|beta|rc|pre|preview|dev|snapshot|milestone|m)[-_.]?[0-9]*$`)
+
+// versionUpdatePattern matches versions that carry a service pack or update suffix (e.g. 1.2.3-sp1 or 8.0-update_5).
+var versionUpdatePattern = regexp.MustCompile(`(?i)^(?P<version>.+?)[-_.](?P<update>(sp|update)[-_]?[0-9]+)$`)
+
+// fourPartVersionPattern matches versions with exactly four numeric components (e.g. 1.2.3.4).
+var fourPartVersionPattern = regexp.MustCompile(`^(?P<version>[0-9]+\.[0-9]+\.[0-9]+)\.[0-9]+$`)
+
+// releaseQualifierVersionPattern matches versions that carry a qualifier only meaning "this is a release" (e.g. 1.2.3.RELEASE or 4.1.0.Final).
+var releaseQualifierVersionPattern = regexp.MustCompile(`(?i)^(?P<version>[0-9]+(\.[0-9]+)*)[-_.](release|final|ga)$`)
+
+// altSeparatorVersionPattern matches numeric versions that use a separator other than a dot (e.g. 1_2_3 or 1,2,3).
+var altSeparatorVersionPattern = regexp.MustCompile(`^[0-9]+([_,][0-9]+)+$`)
+
+// versionLikePattern matches strings that could be a version: they contain at least one digit and no path separators
+// or whitespace (e.g. 1.2.3 but not vendor/golang.org/x/net).
+var versionLikePattern = regexp.MustCompile(`^[^/\\\s]*[0-9][^/\\\s]*$`)
+
+// distroEpochPattern matches the epoch prefix of a distro package version (e.g. the "1:" in 1:2.8.0-3ubuntu1).
+var distroEpochPattern = regexp.MustCompile(`^[0-9]+:`)
+
+// distroSnapshotPattern matches the suffix that distros add to upstream versions that are packaged from a VCS snapshot
+// (e.g. 2.8.0+git20200101.abcdef or 1.2~git20200101).
+var distroSnapshotPattern = regexp.MustCompile(`(?i)[+~](git|svn|hg|bzr)[0-9.]*.*$`)
+
+// splitVersionUpdate separates any service pack or update suffix from the given version, returning the remaining
+// version and the normalized update (e.g. 1.2.3-sp1 -> 1.2.3, sp1). If no update can be found the version is returned
+// as-is with an update of Any.
+func splitVersionUpdate(version string) (string, string) {
+	match := versionUpdatePattern.FindStringSubmatch(version)
+	if match == nil {
+		return version, wfn.Any
+	}
+	update := strings.ToLower(match[versionUpdatePattern.SubexpIndex("update")])
+	update = strings.NewReplacer("-", "", "_", "").Replace(update)
+	return match[versionUpdatePattern.SubexpIndex("version")], update
+}
+
+// candidateVersions returns all versions that should be used when generating CPEs for the given package.
+func candidateVersions(p pkg.Package, cfg Config) []string {
+	version := p.Version
+	switch {
+	case p.Type == pkg.JavaPkg || p.MetadataType == pkg.JavaMetadataType:
+		version = stripJavaVariantClassifier(stripJavaSnapshotQualifier(version))
+	case p.Language == pkg.Go:
+		version = normalizeGoVersion(version)
+	}
+
+	// commonly malformed versions are never used by NVD (e.g. 1.2.3.RELEASE, 1_2_3, and 1,2,3 -> 1.2.3)
+	version = repairVersion(version)
+
+	// never place values that are not versions (e.g. path fragments of a vendored package or (devel)) into the version
+	// field, however, the vendor and product are still meaningful without a version
+	if version != "" && !versionLikePattern.MatchString(version) {
+		version = wfn.Any
+	}
+
+	versions := []string{version}
+
+	if cfg.IncludeRawVersion && p.Version != version && versionLikePattern.MatchString(p.Version) {
+		versions = append(versions, p.Version)
+	}
+
+	if p.Type == pkg.DebPkg || p.Type == pkg.RpmPkg {
+		// NVD only knows of the upstream version, not the version of the distro package
+		if upstream := stripDistroVersionDecorations(version); upstream != "" && upstream != version {
+			versions = append(versions, upstream)
+		}
+	}
+
+	if cfg.IncludeReleaseVersion {
+		if release := releaseVersion(version); release != "" {
+			versions = append(versions, release)
+		}
+	}
+
+	if cfg.IncludeThreePartVersion {
+		if threePart := threePartVersion(version); threePart != "" {
+			versions = append(versions, threePart)
+		}
+	}
+
+	if cfg.IncludeAnyVersion && version != wfn.Any {
+		versions = append(versions, wfn.Any)
+	}
+
+	return versions
+}
+
+// stripDistroVersionDecorations returns the upstream portion of the given distro package version, removing any epoch,
+// distro release (e.g. -3ubuntu1 or -4.el8), and VCS snapshot suffix (e.g. 1:2.8.0+git20200101-3ubuntu1 -> 2.8.0).
+func stripDistroVersionDecorations(version string) string {
+	version = distroEpochPattern.ReplaceAllString(version, "")
+	if i := strings.LastIndex(version, "-"); i > 0 {
+		version = version[:i]
+	}
+	return distroSnapshotPattern.ReplaceAllString(version, "")
+}
+
+// releaseVersion returns the release version for the given pre-release version (e.g. 1.2.3-beta -> 1.2.3). If the
+// given version is not a pre-release then an empty string is returned.
+func releaseVersion(version string) string {
+	match := preReleaseVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return ""
+	}
+	return match[preReleaseVersionPattern.SubexpIndex("release")]
+}
+
+// threePartVersion returns the first three components of the given four-part version (e.g. 1.2.3.4 -> 1.2.3). If the
+// given version is not a four-part version then an empty string is returned.
+func threePartVersion(version string) string {
+	match := fourPartVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return ""
+	}
+	return match[fourPartVersionPattern.SubexpIndex("version")]
+}
+
+// repairVersion makes a best-effort attempt to normalize commonly malformed versions into the form used by NVD
+// (e.g. 1.2.3.RELEASE, 1_2_3, and 1,2,3 -> 1.2.3). Versions that are not recognized are returned as-is.
+func repairVersion(version string) string {
+	version = strings.TrimSpace(version)
+
+	if match := releaseQualifierVersionPattern.FindStringSubmatch(version); match != nil {
+		return match[releaseQualifierVersionPattern.SubexpIndex("version")]
+	}
+
+	if altSeparatorVersionPattern.MatchString(version) {
+		return strings.NewReplacer("_", ".", ",", ".").Replace(version)
+	}
+
+	return version
+}
@@ -0,0 +1,398 @@
+package cpe
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/facebookincubator/nvdtools/wfn"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_splitVersionUpdate(t *testing.T) {
+	tests := []struct {
+		version         string
+		expectedVersion string
+		expectedUpdate  string
+	}{
+		{
+			version:         "1.2.3-sp1",
+			expectedVersion: "1.2.3",
+			expectedUpdate:  "sp1",
+		},
+		{
+			version:         "2008.SP2",
+			expectedVersion: "2008",
+			expectedUpdate:  "sp2",
+		},
+		{
+			version:         "8.0-update_5",
+			expectedVersion: "8.0",
+			expectedUpdate:  "update5",
+		},
+		{
+			version:         "1.2.3",
+			expectedVersion: "1.2.3",
+			expectedUpdate:  wfn.Any,
+		},
+		{
+			version:         "1.2.3-special",
+			expectedVersion: "1.2.3-special",
+			expectedUpdate:  wfn.Any,
+		},
+		{
+			version:         "",
+			expectedVersion: "",
+			expectedUpdate:  wfn.Any,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			version, update := splitVersionUpdate(test.version)
+			assert.Equal(t, test.expectedVersion, version)
+			assert.Equal(t, test.expectedUpdate, update)
+		})
+	}
+}
+
+func Test_releaseVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{version: "1.2.3-beta", expected: "1.2.3"},
+		{version: "1.2.3-beta2", expected: "1.2.3"},
+		{version: "2.0.0-rc.1", expected: "2.0.0"},
+		{version: "5.0.0.Alpha", expected: "5.0.0"},
+		{version: "1.0m1", expected: "1.0"},
+		{version: "1.2.3", expected: ""},
+		{version: "1.2.3-1ubuntu1", expected: ""},
+		{version: "beta", expected: ""},
+		{version: "", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			assert.Equal(t, test.expected, releaseVersion(test.version))
+		})
+	}
+}
+
+func Test_candidateVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		cfg      Config
+		expected []string
+	}{
+		{
+			name:     "pre-release version only by default",
+			p:        pkg.Package{Version: "1.2.3-beta"},
+			cfg:      DefaultConfig(),
+			expected: []string{"1.2.3-beta"},
+		},
+		{
+			name:     "pre-release and release versions",
+			p:        pkg.Package{Version: "1.2.3-beta"},
+			cfg:      Config{IncludeReleaseVersion: true},
+			expected: []string{"1.2.3-beta", "1.2.3"},
+		},
+		{
+			name:     "exact and any version",
+			p:        pkg.Package{Version: "1.2.3"},
+			cfg:      Config{IncludeAnyVersion: true},
+			expected: []string{"1.2.3", wfn.Any},
+		},
+		{
+			name:     "release version",
+			p:        pkg.Package{Version: "1.2.3"},
+			cfg:      Config{IncludeReleaseVersion: true},
+			expected: []string{"1.2.3"},
+		},
+		{
+			name:     "four-part version only by default",
+			p:        pkg.Package{Version: "1.2.3.4"},
+			cfg:      DefaultConfig(),
+			expected: []string{"1.2.3.4"},
+		},
+		{
+			name:     "four-part and three-part versions",
+			p:        pkg.Package{Version: "1.2.3.4"},
+			cfg:      Config{IncludeThreePartVersion: true},
+			expected: []string{"1.2.3.4", "1.2.3"},
+		},
+		{
+			name:     "go incompatible version",
+			p:        pkg.Package{Version: "v2.0.0+incompatible", Language: pkg.Go},
+			cfg:      DefaultConfig(),
+			expected: []string{"2.0.0"},
+		},
+		{
+			name:     "go version with raw version",
+			p:        pkg.Package{Version: "v2.0.0+incompatible", Language: pkg.Go},
+			cfg:      Config{IncludeRawVersion: true},
+			expected: []string{"2.0.0", "v2.0.0+incompatible"},
+		},
+		{
+			name:     "raw version is not repeated when already normalized",
+			p:        pkg.Package{Version: "v1.6.1", Language: pkg.Go},
+			cfg:      Config{IncludeRawVersion: true},
+			expected: []string{"v1.6.1"},
+		},
+		{
+			name:     "repaired version",
+			p:        pkg.Package{Version: "1.2.3.RELEASE"},
+			cfg:      DefaultConfig(),
+			expected: []string{"1.2.3"},
+		},
+		{
+			name:     "repaired version with raw version",
+			p:        pkg.Package{Version: "1_2_3"},
+			cfg:      Config{IncludeRawVersion: true},
+			expected: []string{"1.2.3", "1_2_3"},
+		},
+		{
+			name:     "path fragment is not a version",
+			p:        pkg.Package{Version: "vendor/golang.org/x/net", Language: pkg.Go},
+			cfg:      Config{IncludeAnyVersion: true},
+			expected: []string{wfn.Any},
+		},
+		{
+			name:     "non-numeric version",
+			p:        pkg.Package{Version: "(devel)", Language: pkg.Go},
+			cfg:      DefaultConfig(),
+			expected: []string{wfn.Any},
+		},
+		{
+			name:     "branch name",
+			p:        pkg.Package{Version: "dev-main", Type: pkg.PhpComposerPkg},
+			cfg:      DefaultConfig(),
+			expected: []string{wfn.Any},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, candidateVersions(test.p, test.cfg))
+		})
+	}
+}
+
+func Test_repairVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{
+			version:  "1.2.3.RELEASE",
+			expected: "1.2.3",
+		},
+		{
+			version:  "4.1.0.Final",
+			expected: "4.1.0",
+		},
+		{
+			version:  "2.0-GA",
+			expected: "2.0",
+		},
+		{
+			version:  "1_2_3",
+			expected: "1.2.3",
+		},
+		{
+			version:  "1,2,3",
+			expected: "1.2.3",
+		},
+		{
+			version:  " 1.2.3 ",
+			expected: "1.2.3",
+		},
+		{
+			version:  "1.2.3",
+			expected: "1.2.3",
+		},
+		{
+			version:  "1.2.3-beta_1",
+			expected: "1.2.3-beta_1",
+		},
+		{
+			version:  "release",
+			expected: "release",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			assert.Equal(t, test.expected, repairVersion(test.version))
+		})
+	}
+}
+
+func Test_threePartVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{
+			version:  "1.2.3.4",
+			expected: "1.2.3",
+		},
+		{
+			version:  "10.0.19041.1",
+			expected: "10.0.19041",
+		},
+		{
+			version:  "1.2.3",
+			expected: "",
+		},
+		{
+			version:  "1.2.3.4.5",
+			expected: "",
+		},
+		{
+			version:  "1.2.3.beta",
+			expected: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			assert.Equal(t, test.expected, threePartVersion(test.version))
+		})
+	}
+}
+
+func Test_stripDistroVersionDecorations(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{
+			version:  "1:2.8.0-3ubuntu1",
+			expected: "2.8.0",
+		},
+		{
+			version:  "2.8.0-3ubuntu1.2",
+			expected: "2.8.0",
+		},
+		{
+			version:  "1.1.1n-0+deb11u3",
+			expected: "1.1.1n",
+		},
+		{
+			version:  "1.2.3-4.el8",
+			expected: "1.2.3",
+		},
+		{
+			version:  "2:8.0.1763-16.el8_5.4",
+			expected: "8.0.1763",
+		},
+		{
+			version:  "0.9+git20191218.1.abcdef-2",
+			expected: "0.9",
+		},
+		{
+			version:  "1.2~git20200101-1",
+			expected: "1.2",
+		},
+		{
+			version:  "1.2.3",
+			expected: "1.2.3",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			assert.Equal(t, test.expected, stripDistroVersionDecorations(test.version))
+		})
+	}
+}
+
+func TestGenerateWithConfig_VersionUpdateFromSuffix(t *testing.T) {
+	p := pkg.Package{
+		Name:    "widget",
+		Version: "1.2.3-sp1",
+		Type:    pkg.RpmPkg,
+	}
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected []string
+	}{
+		{
+			name: "update kept in the version by default",
+			cfg:  DefaultConfig(),
+			expected: []string{
+				"cpe:2.3:a:widget:widget:1.2.3-sp1:*:*:*:*:*:*:*",
+				// the rpm release is not part of the upstream version
+				"cpe:2.3:a:widget:widget:1.2.3:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "update split from the version",
+			cfg:  Config{VersionUpdateFromSuffix: true},
+			expected: []string{
+				"cpe:2.3:a:widget:widget:1.2.3:sp1:*:*:*:*:*:*",
+				"cpe:2.3:a:widget:widget:1.2.3:*:*:*:*:*:*:*",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, cpeStrings(GenerateWithConfig(p, test.cfg)))
+		})
+	}
+}
+
+func TestGenerateWithConfig_IncludeReleaseVersion(t *testing.T) {
+	p := pkg.Package{
+		Name:    "widget",
+		Version: "1.2.3-beta",
+		Type:    pkg.RpmPkg,
+	}
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:widget:widget:1.2.3-beta:*:*:*:*:*:*:*",
+		"cpe:2.3:a:widget:widget:1.2.3:*:*:*:*:*:*:*",
+	}, cpeStrings(GenerateWithConfig(p, Config{IncludeReleaseVersion: true})))
+}
+
+func TestGenerateWithConfig_IncludeAnyVersion(t *testing.T) {
+	p := pkg.Package{
+		Name:    "widget",
+		Version: "1.2.3",
+		Type:    pkg.RpmPkg,
+	}
+
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:widget:widget:1.2.3:*:*:*:*:*:*:*",
+		"cpe:2.3:a:widget:widget:*:*:*:*:*:*:*:*",
+	}, cpeStrings(GenerateWithConfig(p, Config{IncludeAnyVersion: true})))
+}
+
+func TestGeneratePackageCPEs_NonVersion(t *testing.T) {
+	p := pkg.Package{
+		Name:     "golang.org/x/net",
+		Version:  "vendor/golang.org/x/net",
+		Language: pkg.Go,
+		Type:     pkg.GoModulePkg,
+	}
+
+	assert.Equal(t, []string{`cpe:2.3:a:golang:x\/net:*:*:*:*:*:*:*:*`}, cpeStrings(Generate(p)))
+}
+
+func TestGenerateWithConfig_IncludeRawVersion(t *testing.T) {
+	p := pkg.Package{
+		Name:     "github.com/spf13/cobra",
+		Version:  "v2.0.0+incompatible",
+		Language: pkg.Go,
+		Type:     pkg.GoModulePkg,
+	}
+
+	assert.Equal(t, []string{
+		"cpe:2.3:a:spf13:cobra:2.0.0:*:*:*:*:*:*:*",
+	}, cpeStrings(Generate(p)))
+
+	cfg := DefaultConfig()
+	cfg.IncludeRawVersion = true
+	assert.ElementsMatch(t, []string{
+		"cpe:2.3:a:spf13:cobra:2.0.0:*:*:*:*:*:*:*",
+		"cpe:2.3:a:spf13:cobra:v2.0.0\\+incompatible:*:*:*:*:*:*:*",
+	}, cpeStrings(GenerateWithConfig(p, cfg)))
+}
@@ -1,6 +1,7 @@
 package cataloger
 
 import (
+	"github.com/anchore/syft/syft/pkg/cataloger/common/cpe"
 	"github.com/anchore/syft/syft/pkg/cataloger/java"
 )
 
@@ -8,6 +9,8 @@ type Config struct {
 	Search                 SearchConfig
 	Catalogers             []string
 	ExternalSourcesEnabled bool
+	DisableCPEs            bool // when true no CPEs are attached to packages, which is useful when only PURLs are needed
+	CPE                    cpe.Config
 }
 
 func DefaultConfig() Config {
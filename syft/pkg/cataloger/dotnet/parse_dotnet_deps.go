@@ -15,7 +15,12 @@ import (
 var _ common.ParserFn = parseDotnetDeps
 
 type dotnetDeps struct {
-	Libraries map[string]dotnetDepsLibrary `json:"libraries"`
+	RuntimeTarget dotnetRuntimeTarget          `json:"runtimeTarget"`
+	Libraries     map[string]dotnetDepsLibrary `json:"libraries"`
+}
+
+type dotnetRuntimeTarget struct {
+	Name string `json:"name"`
 }
 
 type dotnetDepsLibrary struct {
@@ -36,7 +41,7 @@ func parseDotnetDeps(path string, reader io.Reader) ([]*pkg.Package, []artifact.
 	}
 
 	for nameVersion, lib := range p.Libraries {
-		dotnetPkg := newDotnetDepsPackage(nameVersion, lib)
+		dotnetPkg := newDotnetDepsPackage(nameVersion, lib, p.RuntimeTarget.Name)
 
 		if dotnetPkg != nil {
 			packages = append(packages, dotnetPkg)
@@ -46,7 +51,7 @@ func parseDotnetDeps(path string, reader io.Reader) ([]*pkg.Package, []artifact.
 	return packages, nil, nil
 }
 
-func newDotnetDepsPackage(nameVersion string, lib dotnetDepsLibrary) *pkg.Package {
+func newDotnetDepsPackage(nameVersion string, lib dotnetDepsLibrary, targetFramework string) *pkg.Package {
 	if lib.Type != "package" {
 		return nil
 	}
@@ -62,11 +67,12 @@ func newDotnetDepsPackage(nameVersion string, lib dotnetDepsLibrary) *pkg.Packag
 		Type:         pkg.DotnetPkg,
 		MetadataType: pkg.DotnetDepsMetadataType,
 		Metadata: &pkg.DotnetDepsMetadata{
-			Name:     name,
-			Version:  version,
-			Path:     lib.Path,
-			Sha512:   lib.Sha512,
-			HashPath: lib.HashPath,
+			Name:            name,
+			Version:         version,
+			Path:            lib.Path,
+			Sha512:          lib.Sha512,
+			HashPath:        lib.HashPath,
+			TargetFramework: targetFramework,
 		},
 	}
 }
@@ -197,3 +197,23 @@ func TestParseDotnetDeps(t *testing.T) {
 
 	assertPackagesEqual(t, actual, expected)
 }
+
+func TestParseDotnetDeps_TargetFramework(t *testing.T) {
+	fixture, err := os.Open("test-fixtures/TestLibrary.deps.json")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parseDotnetDeps(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatalf("failed to parse deps.json: %+v", err)
+	}
+
+	assert.NotEmpty(t, actual)
+	for _, p := range actual {
+		metadata, ok := p.Metadata.(*pkg.DotnetDepsMetadata)
+		if assert.True(t, ok, "unexpected metadata type: %T", p.Metadata) {
+			assert.Equal(t, ".NETCoreApp,Version=v6.0", metadata.TargetFramework)
+		}
+	}
+}
@@ -37,6 +37,7 @@ func (c *Cataloger) UsesExternalSources() bool {
 func (c *Cataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
 	var pkgs []pkg.Package
 
+	// note: shared libraries are included, which covers go plugins (-buildmode=plugin) since they carry build info too
 	fileMatches, err := resolver.FilesByMIMEType(internal.ExecutableMIMETypeSet.List()...)
 	if err != nil {
 		return pkgs, nil, fmt.Errorf("failed to find bin by mime types: %w", err)
@@ -12,6 +12,8 @@ import (
 	"testing"
 
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common/cpe"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/unionreader"
 	"github.com/anchore/syft/syft/source"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -117,6 +119,40 @@ func Test_getGOARCHFromBin(t *testing.T) {
 
 }
 
+func Test_scanFile_plugin(t *testing.T) {
+	runMakeTarget(t, "archs")
+
+	path := "test-fixtures/archs/binaries/greeter-plugin-linux-amd64.so"
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	reader, err := unionreader.GetUnionReader(f)
+	require.NoError(t, err)
+
+	// plugins (-buildmode=plugin) carry the same build info as regular go binaries
+	mods, archs := scanFile(reader, path)
+	require.Len(t, mods, 1)
+	require.Len(t, archs, 1)
+	assert.Equal(t, "github.com/anchore/greeter", mods[0].Main.Path)
+	assert.Equal(t, "amd64", archs[0])
+
+	pkgs := buildGoPkgInfo(source.NewLocation(path), mods[0], archs[0])
+	require.Len(t, pkgs, 1)
+	main := pkgs[0]
+	assert.Equal(t, "github.com/anchore/greeter", main.Name)
+	assert.Equal(t, pkg.GoModulePkg, main.Type)
+	assert.Equal(t, "github.com/anchore/greeter", main.Metadata.(pkg.GolangBinMetadata).MainModule)
+
+	// the fixture is built without VCS info, so the version is set to show CPEs are generated as for any go binary
+	main.Version = "v1.0.0"
+	var cpes []string
+	for _, c := range cpe.Generate(main) {
+		cpes = append(cpes, pkg.CPEString(c))
+	}
+	assert.Contains(t, cpes, "cpe:2.3:a:anchore:greeter:1.0.0:*:*:*:*:*:*:*")
+}
+
 func TestBuildGoPkgInfo(t *testing.T) {
 	const (
 		goCompiledVersion = "1.18"
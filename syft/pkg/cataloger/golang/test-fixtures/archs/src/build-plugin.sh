@@ -0,0 +1,20 @@
+#!/usr/bin/env bash
+set -uxe
+
+# note: plugins must be built with cgo for the native platform of the toolchain, so the platform is pinned instead of cross-compiling
+# note: gocache override is so we can run docker build not as root in a container without permission issues
+
+BINARY=$1
+CTRID=$(docker create --platform linux/amd64 -e CGO_ENABLED=1 -u "$(id -u):$(id -g)" -e GOCACHE=/tmp -w /src/plugin golang:1.17 go build -buildmode=plugin -o greeter.so .)
+
+function cleanup() {
+  docker rm "${CTRID}"
+}
+
+trap cleanup EXIT
+set +e
+
+# note: pwd = parent directory (archs)
+docker cp "$(pwd)/src" "${CTRID}:/"
+docker start -a "${CTRID}"
+docker cp "${CTRID}:/src/plugin/greeter.so" "$BINARY"
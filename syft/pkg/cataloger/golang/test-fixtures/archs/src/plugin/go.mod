@@ -0,0 +1,3 @@
+module github.com/anchore/greeter
+
+go 1.17
@@ -0,0 +1,5 @@
+package main
+
+func Greet() string {
+	return "свобода!"
+}
@@ -52,6 +52,7 @@ func newPomProject(path string, p gopom.Project) *pkg.PomProject {
 		Name:        p.Name,
 		Description: cleanDescription(p.Description),
 		URL:         p.URL,
+		Relocation:  pomRelocation(p.DistributionManagement.Relocation),
 	}
 }
 
@@ -97,6 +98,17 @@ func pomParent(parent gopom.Parent) (result *pkg.PomParent) {
 	return result
 }
 
+func pomRelocation(relocation gopom.Relocation) (result *pkg.PomRelocation) {
+	if relocation.ArtifactID != "" || relocation.GroupID != "" || relocation.Version != "" {
+		result = &pkg.PomRelocation{
+			GroupID:    relocation.GroupID,
+			ArtifactID: relocation.ArtifactID,
+			Version:    relocation.Version,
+		}
+	}
+	return result
+}
+
 func cleanDescription(original string) (cleaned string) {
 	descriptionLines := strings.Split(original, "\n")
 	for _, line := range descriptionLines {
@@ -77,6 +77,19 @@ func Test_parsePomXMLProject(t *testing.T) {
 				URL:         "http://commons.apache.org/proper/commons-codec/",
 			},
 		},
+		{
+			expected: pkg.PomProject{
+				Path:       "test-fixtures/pom/relocated.pom.xml",
+				GroupID:    "mysql",
+				ArtifactID: "mysql-connector-java",
+				Version:    "8.0.33",
+				Name:       "MySQL Connector/J",
+				Relocation: &pkg.PomRelocation{
+					GroupID:    "com.mysql",
+					ArtifactID: "mysql-connector-j",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -56,3 +56,52 @@ func TestParsePoetryLock(t *testing.T) {
 		t.Errorf("returned package list differed from expectation: %+v", differences)
 	}
 }
+
+func TestParsePoetryLock_GitSource(t *testing.T) {
+	expected := []*pkg.Package{
+		{
+			Name:         "requests",
+			Version:      "2.28.1",
+			Language:     pkg.Python,
+			Type:         pkg.PythonPkg,
+			MetadataType: pkg.PythonPackageMetadataType,
+			Metadata: pkg.PythonPackageMetadata{
+				Name:    "requests",
+				Version: "2.28.1",
+				DirectURLOrigin: &pkg.PythonDirectURLOriginInfo{
+					URL:      "https://github.com/psf/requests.git",
+					CommitID: "a7da1ab3498b10ec3a3582244c94b2845f8a8e71",
+					VCS:      "git",
+				},
+			},
+		},
+		{
+			Name:     "pillow",
+			Version:  "9.2.0",
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+		},
+		{
+			// package index sources (e.g. a private mirror) are not direct references to the package
+			Name:     "six",
+			Version:  "1.16.0",
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+		},
+	}
+
+	fixture, err := os.Open("test-fixtures/poetry-git/poetry.lock")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parsePoetryLock(fixture.Name(), fixture)
+	if err != nil {
+		t.Error(err)
+	}
+
+	differences := deep.Equal(expected, actual)
+	if differences != nil {
+		t.Errorf("returned package list differed from expectation: %+v", differences)
+	}
+}
@@ -3,19 +3,55 @@ package python
 import "github.com/anchore/syft/syft/pkg"
 
 type PoetryMetadataPackage struct {
-	Name        string `toml:"name"`
-	Version     string `toml:"version"`
-	Category    string `toml:"category"`
-	Description string `toml:"description"`
-	Optional    bool   `toml:"optional"`
+	Name        string                       `toml:"name"`
+	Version     string                       `toml:"version"`
+	Category    string                       `toml:"category"`
+	Description string                       `toml:"description"`
+	Optional    bool                         `toml:"optional"`
+	Source      *PoetryMetadataPackageSource `toml:"source"`
+}
+
+// PoetryMetadataPackageSource describes where a package was resolved from when it is not from the default package index.
+type PoetryMetadataPackageSource struct {
+	Type              string `toml:"type"`
+	URL               string `toml:"url"`
+	Reference         string `toml:"reference"`
+	ResolvedReference string `toml:"resolved_reference"`
+}
+
+// poetryDirectSourceTypes are the source types that describe a direct reference to the package (see PEP 610), as opposed
+// to a package index (e.g. "legacy" sources for private index mirrors)
+var poetryDirectSourceTypes = map[string]bool{
+	"git":       true,
+	"url":       true,
+	"file":      true,
+	"directory": true,
 }
 
 // Pkg returns the standard `pkg.Package` representation of the package referenced within the poetry.lock metadata.
 func (p PoetryMetadataPackage) Pkg() *pkg.Package {
-	return &pkg.Package{
+	result := &pkg.Package{
 		Name:     p.Name,
 		Version:  p.Version,
 		Language: pkg.Python,
 		Type:     pkg.PythonPkg,
 	}
+
+	if p.Source != nil && p.Source.URL != "" && poetryDirectSourceTypes[p.Source.Type] {
+		origin := &pkg.PythonDirectURLOriginInfo{
+			URL: p.Source.URL,
+		}
+		if p.Source.Type == "git" {
+			origin.VCS = p.Source.Type
+			origin.CommitID = p.Source.ResolvedReference
+		}
+		result.MetadataType = pkg.PythonPackageMetadataType
+		result.Metadata = pkg.PythonPackageMetadata{
+			Name:            p.Name,
+			Version:         p.Version,
+			DirectURLOrigin: origin,
+		}
+	}
+
+	return result
 }
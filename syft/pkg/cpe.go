@@ -113,19 +113,35 @@ func stripSlashes(s string) string {
 }
 
 func CPEString(c CPE) string {
+	return sanitizeCPE(c, sanitize).BindToFmtString()
+}
+
+// CPEURIString returns the CPE 2.2 URI binding of the given CPE (e.g. cpe:/a:vendor:product:1.0), which is still
+// required by some legacy tooling. Any (*) attributes are left empty and NA (-) attributes are bound as-is.
+func CPEURIString(c CPE) string {
+	return sanitizeCPE(c, func(s string) string {
+		if s == wfn.NA {
+			// the URI binding has no way to express a literal "-", so this is always the logical value
+			return s
+		}
+		return sanitize(s)
+	}).BindToURI()
+}
+
+func sanitizeCPE(c CPE, fn func(string) string) CPE {
 	output := CPE{}
-	output.Vendor = sanitize(c.Vendor)
-	output.Product = sanitize(c.Product)
-	output.Language = sanitize(c.Language)
-	output.Version = sanitize(c.Version)
-	output.TargetSW = sanitize(c.TargetSW)
-	output.Part = sanitize(c.Part)
-	output.Edition = sanitize(c.Edition)
-	output.Other = sanitize(c.Other)
-	output.SWEdition = sanitize(c.SWEdition)
-	output.TargetHW = sanitize(c.TargetHW)
-	output.Update = sanitize(c.Update)
-	return output.BindToFmtString()
+	output.Vendor = fn(c.Vendor)
+	output.Product = fn(c.Product)
+	output.Language = fn(c.Language)
+	output.Version = fn(c.Version)
+	output.TargetSW = fn(c.TargetSW)
+	output.Part = fn(c.Part)
+	output.Edition = fn(c.Edition)
+	output.Other = fn(c.Other)
+	output.SWEdition = fn(c.SWEdition)
+	output.TargetHW = fn(c.TargetHW)
+	output.Update = fn(c.Update)
+	return output
 }
 
 // sanitize is a modified version of WFNize function from nvdtools
@@ -1,6 +1,7 @@
 package pkg
 
 import (
+	"math/rand"
 	"sort"
 	"testing"
 
@@ -101,3 +102,39 @@ func TestCPESpecificity(t *testing.T) {
 	}
 
 }
+
+func TestCPESpecificity_DeterministicOrder(t *testing.T) {
+	// all of these have the same specificity score and field length, so only the tie-breaker decides the order
+	input := []CPE{
+		mustCPE("cpe:2.3:a:abc:def:1.0:*:*:*:*:*:*:*"),
+		mustCPE("cpe:2.3:a:abd:def:1.0:*:*:*:*:*:*:*"),
+		mustCPE("cpe:2.3:a:abc:deg:1.0:*:*:*:*:*:*:*"),
+		mustCPE("cpe:2.3:a:abc:def:1.1:*:*:*:*:*:*:*"),
+		mustCPE("cpe:2.3:a:abc:def:1.0:*:*:en:*:*:*:*"),
+		mustCPE("cpe:2.3:a:abc:def:1.0:sp1:*:*:*:*:*:*"),
+	}
+
+	expected := make([]CPE, len(input))
+	copy(expected, input)
+	sort.Sort(CPEBySpecificity(expected))
+
+	var expectedStrings []string
+	for _, c := range expected {
+		expectedStrings = append(expectedStrings, CPEString(c))
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		shuffled := make([]CPE, len(input))
+		copy(shuffled, input)
+		r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+		sort.Sort(CPEBySpecificity(shuffled))
+
+		var actual []string
+		for _, c := range shuffled {
+			actual = append(actual, CPEString(c))
+		}
+		assert.Equal(t, expectedStrings, actual)
+	}
+}
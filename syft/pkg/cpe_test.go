@@ -232,3 +232,50 @@ func Test_RoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func Test_CPEURIString(t *testing.T) {
+	tests := []struct {
+		name     string
+		cpe      string
+		expected string
+	}{
+		{
+			name:     "normal",
+			cpe:      "cpe:2.3:a:some-vendor:name:3.2:*:*:*:*:*:*:*",
+			expected: "cpe:/a:some-vendor:name:3.2",
+		},
+		{
+			name:     "any values are omitted",
+			cpe:      "cpe:2.3:a:some-vendor:name:*:*:*:*:*:*:*:*",
+			expected: "cpe:/a:some-vendor:name",
+		},
+		{
+			name:     "not applicable values are kept",
+			cpe:      "cpe:2.3:a:some-vendor:name:3.2:-:*:*:*:*:*:*",
+			expected: "cpe:/a:some-vendor:name:3.2:-",
+		},
+		{
+			name:     "extended attributes are packed into the edition",
+			cpe:      "cpe:2.3:a:10web:form_maker:1.0.0:*:*:*:*:wordpress:*:*",
+			expected: "cpe:/a:10web:form_maker:1.0.0::~~~wordpress~~",
+		},
+		{
+			name:     "escaped characters are percent encoded",
+			cpe:      `cpe:2.3:a:\$0.99_kindle_books_project:\$0.99_kindle_books:6:*:*:*:*:android:*:*`,
+			expected: "cpe:/a:%240.99_kindle_books_project:%240.99_kindle_books:6::~~~android~~",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := MustCPE(test.cpe)
+			actual := CPEURIString(c)
+			assert.Equal(t, test.expected, actual)
+
+			// the URI must parse back into the same attributes
+			roundTrip, err := NewCPE(actual)
+			require.NoError(t, err)
+			assert.Equal(t, c, roundTrip)
+		})
+	}
+}
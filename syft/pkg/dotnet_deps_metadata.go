@@ -6,11 +6,12 @@ import (
 )
 
 type DotnetDepsMetadata struct {
-	Name     string `mapstructure:"name" json:"name"`
-	Version  string `mapstructure:"version" json:"version"`
-	Path     string `mapstructure:"path" json:"path"`
-	Sha512   string `mapstructure:"sha512" json:"sha512"`
-	HashPath string `mapstructure:"hashPath" json:"hashPath"`
+	Name            string `mapstructure:"name" json:"name"`
+	Version         string `mapstructure:"version" json:"version"`
+	Path            string `mapstructure:"path" json:"path"`
+	Sha512          string `mapstructure:"sha512" json:"sha512"`
+	HashPath        string `mapstructure:"hashPath" json:"hashPath"`
+	TargetFramework string `mapstructure:"targetFramework" json:"targetFramework,omitempty"`
 }
 
 func (m DotnetDepsMetadata) PackageURL(_ *linux.Release) string {
@@ -42,14 +42,15 @@ type PomProperties struct {
 
 // PomProject represents fields of interest extracted from a Java archive's pom.xml file. See https://maven.apache.org/ref/3.6.3/maven-model/maven.html for more details.
 type PomProject struct {
-	Path        string     `json:"path"`
-	Parent      *PomParent `json:"parent,omitempty"`
-	GroupID     string     `json:"groupId"`
-	ArtifactID  string     `json:"artifactId"`
-	Version     string     `json:"version"`
-	Name        string     `json:"name"`
-	Description string     `json:"description,omitempty"`
-	URL         string     `json:"url,omitempty"`
+	Path        string         `json:"path"`
+	Parent      *PomParent     `json:"parent,omitempty"`
+	GroupID     string         `json:"groupId"`
+	ArtifactID  string         `json:"artifactId"`
+	Version     string         `json:"version"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	URL         string         `json:"url,omitempty"`
+	Relocation  *PomRelocation `json:"relocation,omitempty"`
 }
 
 // PomParent contains the fields within the <parent> tag in a pom.xml file
@@ -59,6 +60,14 @@ type PomParent struct {
 	Version    string `json:"version"`
 }
 
+// PomRelocation contains the fields within the <distributionManagement><relocation> tag in a pom.xml file, which
+// indicates that the artifact has moved to new coordinates. Fields that are not set are the same as the original artifact.
+type PomRelocation struct {
+	GroupID    string `json:"groupId,omitempty"`
+	ArtifactID string `json:"artifactId,omitempty"`
+	Version    string `json:"version,omitempty"`
+}
+
 // PkgTypeIndicated returns the package Type indicated by the data contained in the PomProperties.
 func (p PomProperties) PkgTypeIndicated() Type {
 	if internal.HasAnyOfPrefixes(p.GroupID, jenkinsPluginPomPropertiesGroupIDs...) || strings.Contains(p.GroupID, ".jenkins.plugin") {